@@ -14,10 +14,17 @@ import (
 	"devopstoolkit/youtube-automation/internal/app"
 	"devopstoolkit/youtube-automation/internal/configuration"
 	"devopstoolkit/youtube-automation/internal/platform/bluesky"
+	"devopstoolkit/youtube-automation/internal/publishing"
 )
 
 var version = "dev" // Will be overwritten by linker flags during release build
 
+// shutdownTimeout bounds how long exiting waits for in-flight YouTube operations
+const shutdownTimeout = 30 * time.Second
+
+// auditLog is the audit trail of YouTube operations, nil unless one was requested
+var auditLog *publishing.AuditLogger
+
 func main() {
 	// Check for version flag before anything else
 	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "version") {
@@ -42,19 +49,63 @@ func main() {
 		}
 	}
 
+	// Keep an audit trail of YouTube operations if requested
+	if auditPath := configuration.GlobalSettings.YouTube.AuditLog; auditPath != "" {
+		var err error
+		auditLog, err = publishing.NewAuditLogger(auditPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Audit log error: %s\n", err)
+			os.Exit(1)
+		}
+		publishing.SetAuditLogger(auditLog)
+	}
+
 	// Check if API server should be started
 	if configuration.GlobalSettings.API.Enabled {
 		startAPIServer()
 	} else {
-		// Start the CLI application
+		// Start the CLI application, shutting down cleanly if it is interrupted or terminated
+		go exitOnSignal()
 		application := app.New()
 		if err := application.Run(); err != nil {
 			fmt.Fprintf(os.Stderr, "Application error: %v\n", err)
-			os.Exit(1)
+			exit(1)
+		}
+		exit(0)
+	}
+}
+
+// shutdown waits for in-flight YouTube operations, flushes their metrics and audit records,
+// and closes the audit log.
+func shutdown(ctx context.Context) {
+	if err := publishing.Shutdown(ctx); err != nil {
+		log.Printf("Error flushing YouTube operations: %v", err)
+	}
+	if auditLog != nil {
+		if err := auditLog.Close(); err != nil {
+			log.Printf("Error closing audit log: %v", err)
 		}
 	}
 }
 
+// exit shuts down and exits with code. os.Exit skips deferred calls, so everything that must
+// be flushed is done here first.
+func exit(code int) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	shutdown(ctx)
+	cancel()
+	os.Exit(code)
+}
+
+// exitOnSignal exits once the CLI is interrupted or terminated.
+func exitOnSignal() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	<-c
+	log.Println("Received interrupt signal, shutting down...")
+	exit(1)
+}
+
 func startAPIServer() {
 	server := api.NewServer()
 	
@@ -79,13 +130,14 @@ func startAPIServer() {
 	log.Println("Received interrupt signal, shutting down...")
 	
 	// Create a context with timeout for graceful shutdown
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
 	
 	// Shutdown the server
 	if err := server.Stop(ctx); err != nil {
 		log.Printf("Error during server shutdown: %v", err)
 	}
+	shutdown(ctx)
 	
 	wg.Wait()
 	log.Println("Server shutdown complete")
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
+	"devopstoolkit/youtube-automation/internal/publishing"
 	"devopstoolkit/youtube-automation/internal/storage"
 	"devopstoolkit/youtube-automation/internal/workflow"
 	"devopstoolkit/youtube-automation/pkg/testutil"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -149,3 +152,28 @@ func TestVideoPhaseTransitions(t *testing.T) {
 	video.Sponsorship.Blocked = "Some reason"
 	testPhase(video, workflow.PhaseSponsoredBlocked, "after setting sponsorship blocked")
 }
+
+// TestShutdown tests that shutting down stops new YouTube operations and closes the audit log.
+// Publishing stays shut down afterwards, so no other test here may start YouTube operations.
+func TestShutdown(t *testing.T) {
+	logger, err := publishing.NewAuditLogger(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %v", err)
+	}
+	auditLog = logger
+	publishing.SetAuditLogger(logger)
+	defer func() {
+		auditLog = nil
+		publishing.SetAuditLogger(nil)
+	}()
+
+	shutdown(context.Background())
+
+	err = publishing.SetThumbnail(context.Background(), nil, "dQw4w9WgXcQ", "thumbnail.png")
+	if !errors.Is(err, publishing.ErrShuttingDown) {
+		t.Errorf("Expected operations after shutdown to fail with ErrShuttingDown, got %v", err)
+	}
+	if err := logger.Close(); err == nil {
+		t.Errorf("Expected the audit log to be closed by shutdown")
+	}
+}
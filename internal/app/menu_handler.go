@@ -310,7 +310,9 @@ func (m *MenuHandler) ChooseIndex() error {
 		}
 		if len(item.Category) > 0 && len(item.Name) > 0 {
 			index = append(index, item)
-			yaml.WriteIndex(index)
+			if err := yaml.WriteIndex(index); err != nil {
+				return fmt.Errorf("failed to write video index for create: %w", err)
+			}
 		}
 	case indexListVideos:
 		for {
@@ -1013,8 +1015,10 @@ func (m *MenuHandler) handleEditVideoPhases(videoToEdit storage.Video) error {
 				// Action: Upload Video to YouTube if requested
 				if uploadTrigger && updatedVideo.UploadVideo != "" {
 					fmt.Println(m.orangeStyle.Render(fmt.Sprintf("Attempting to upload video: %s", updatedVideo.UploadVideo)))
-					newVideoID := publishing.UploadVideo(&updatedVideo) // Pass the whole struct
-					if newVideoID == "" {
+					newVideoID := publishing.UploadVideoInteractive(&updatedVideo) // Pass the whole struct
+					if newVideoID == publishing.DryRunVideoID {
+						fmt.Println(m.orangeStyle.Render("Dry run: the video was not uploaded."))
+					} else if newVideoID == "" {
 						log.Printf(m.errorStyle.Render(fmt.Sprintf("Failed to upload video from path: %s. YouTube API might have returned an empty ID or an error occurred.", updatedVideo.UploadVideo)))
 						// Potentially revert uploadTrigger or handle error more explicitly.
 						// For now, if upload fails, newVideoID will be empty, and updatedVideo.VideoId won't be set with a new ID.
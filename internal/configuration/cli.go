@@ -63,6 +63,7 @@ type SettingsAnthropicAI struct {
 type SettingsYouTube struct {
 	APIKey    string `yaml:"apiKey"`
 	ChannelId string `yaml:"channelId"`
+	AuditLog  string `yaml:"auditLog"`
 }
 
 type SettingsBluesky struct {
@@ -110,6 +111,7 @@ func init() {
 	RootCmd.Flags().StringVar(&GlobalSettings.AI.Anthropic.Key, "anthropic-key", GlobalSettings.AI.Anthropic.Key, "Anthropic API key. Environment variable `ANTHROPIC_API_KEY` is supported as well. (required for anthropic)")
 	RootCmd.Flags().StringVar(&GlobalSettings.AI.Anthropic.Model, "anthropic-model", GlobalSettings.AI.Anthropic.Model, "Anthropic model (e.g., claude-3-sonnet-20240229). (required for anthropic)")
 	RootCmd.Flags().StringVar(&GlobalSettings.YouTube.APIKey, "youtube-api-key", GlobalSettings.YouTube.APIKey, "YouTube API key. Environment variable `YOUTUBE_API_KEY` is supported as well. (required)")
+	RootCmd.Flags().StringVar(&GlobalSettings.YouTube.AuditLog, "youtube-audit-log", GlobalSettings.YouTube.AuditLog, "File to append a JSON record of every YouTube upload and update to.")
 	RootCmd.Flags().StringVar(&GlobalSettings.Hugo.Path, "hugo-path", GlobalSettings.Hugo.Path, "Path to the repo with Hugo posts. (required)")
 	RootCmd.Flags().StringVar(&GlobalSettings.Bluesky.Identifier, "bluesky-identifier", GlobalSettings.Bluesky.Identifier, "Bluesky username/identifier (e.g., username.bsky.social)")
 	RootCmd.Flags().StringVar(&GlobalSettings.Bluesky.Password, "bluesky-password", GlobalSettings.Bluesky.Password, "Bluesky password. Environment variable `BLUESKY_PASSWORD` is supported as well.")
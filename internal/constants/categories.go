@@ -0,0 +1,49 @@
+package constants
+
+import "strings"
+
+// DefaultCategoryID is the YouTube category used when a video's category can't be resolved (Science & Technology)
+const DefaultCategoryID = "28"
+
+// CategoryMap maps normalized YouTube category names to their numeric category IDs
+var CategoryMap = map[string]string{
+	"film-and-animation":      "1",
+	"autos-and-vehicles":      "2",
+	"music":                   "10",
+	"pets-and-animals":        "15",
+	"sports":                  "17",
+	"travel-and-events":       "19",
+	"gaming":                  "20",
+	"people-and-blogs":        "22",
+	"comedy":                  "23",
+	"entertainment":           "24",
+	"news-and-politics":       "25",
+	"howto-and-style":         "26",
+	"education":               "27",
+	"science-and-technology":  "28",
+	"nonprofits-and-activism": "29",
+}
+
+// CategoryID resolves a category name to a YouTube category ID. Names are matched
+// case-insensitively, with spaces, underscores and "&" treated like "-" and "and"
+// (so "Science & Technology" matches), and numeric IDs from CategoryMap are accepted as-is.
+func CategoryID(category string) (string, bool) {
+	normalized := strings.ToLower(strings.TrimSpace(category))
+	if normalized == "" {
+		return "", false
+	}
+	normalized = strings.ReplaceAll(normalized, "&", " and ")
+	normalized = strings.Join(strings.FieldsFunc(normalized, func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-'
+	}), "-")
+
+	if id, ok := CategoryMap[normalized]; ok {
+		return id, true
+	}
+	for _, id := range CategoryMap {
+		if id == normalized {
+			return id, true
+		}
+	}
+	return "", false
+}
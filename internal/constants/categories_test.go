@@ -0,0 +1,32 @@
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryID(t *testing.T) {
+	tests := []struct {
+		name       string
+		category   string
+		expectedID string
+		expectedOK bool
+	}{
+		{name: "Slug", category: "education", expectedID: "27", expectedOK: true},
+		{name: "Display name", category: "Science & Technology", expectedID: "28", expectedOK: true},
+		{name: "Underscores", category: "people_and_blogs", expectedID: "22", expectedOK: true},
+		{name: "Numeric ID", category: "10", expectedID: "10", expectedOK: true},
+		{name: "Unknown", category: "testing", expectedOK: false},
+		{name: "Unknown numeric ID", category: "99", expectedOK: false},
+		{name: "Empty", category: "", expectedOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := CategoryID(tt.category)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedID, id)
+		})
+	}
+}
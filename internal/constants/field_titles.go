@@ -0,0 +1,79 @@
+package constants
+
+import "strings"
+
+// FieldKey identifies a form field by its English title, so the FieldTitle* constants can be
+// passed wherever a FieldKey is expected.
+type FieldKey string
+
+// FieldTitleSet maps fields to their titles in one language. Fields it leaves out keep their
+// English title.
+type FieldTitleSet map[FieldKey]string
+
+// FieldTitleSets holds the localized field titles by lowercase locale, such as "de".
+var FieldTitleSets = map[string]FieldTitleSet{
+	"de": {
+		FieldTitleProjectName:        "Projektname",
+		FieldTitleProjectURL:         "Projekt-URL",
+		FieldTitleSponsorshipAmount:  "Sponsoring-Betrag",
+		FieldTitleSponsorshipEmails:  "Sponsoring-E-Mails (kommagetrennt)",
+		FieldTitleSponsorshipBlocked: "Grund für Sponsoring-Sperre",
+		FieldTitlePublishDate:        "Veröffentlichungsdatum (YYYY-MM-DDTHH:MM)",
+		FieldTitleDelayed:            "Verzögert",
+		FieldTitleGistPath:           "Gist-Pfad (.md-Datei)",
+
+		FieldTitleCodeDone:            "Code fertig",
+		FieldTitleTalkingHeadDone:     "Talking Head fertig",
+		FieldTitleScreenRecordingDone: "Bildschirmaufnahme fertig",
+		FieldTitleRelatedVideos:       "Verwandte Videos (kommagetrennt)",
+		FieldTitleThumbnailsDone:      "Thumbnails fertig",
+		FieldTitleDiagramsDone:        "Diagramme fertig",
+		FieldTitleScreenshotsDone:     "Screenshots fertig",
+		FieldTitleFilesLocation:       "Speicherort der Dateien (z. B. Google-Drive-Link)",
+		FieldTitleTagline:             "Slogan",
+		FieldTitleTaglineIdeas:        "Slogan-Ideen",
+		FieldTitleOtherLogos:          "Weitere Logos/Assets",
+
+		FieldTitleTitle:            "Titel",
+		FieldTitleDescription:      "Beschreibung",
+		FieldTitleDescriptionTags:  "Beschreibungs-Tags",
+		FieldTitleAnimationsScript: "Animationsskript",
+
+		FieldTitleThumbnailPath: "Thumbnail-Pfad",
+		FieldTitleMembers:       "Mitglieder (kommagetrennt)",
+		FieldTitleRequestEdit:   "Schnittanfrage",
+		FieldTitleTimecodes:     "Zeitmarken",
+		FieldTitleMovieDone:     "Film fertig",
+		FieldTitleSlidesDone:    "Folien fertig",
+
+		FieldTitleVideoFilePath:   "Pfad der Videodatei",
+		FieldTitleUploadToYouTube: "Video auf YouTube hochladen?",
+		FieldTitleCurrentVideoID:  "Aktuelle YouTube-Video-ID",
+		FieldTitleCreateHugo:      "Hugo-Beitrag erstellen/aktualisieren",
+
+		FieldTitleDOTPosted:           "DevOpsToolkit-Beitrag gesendet (manuell)",
+		FieldTitleBlueSkyPosted:       "BlueSky-Beitrag gesendet",
+		FieldTitleLinkedInPosted:      "LinkedIn-Beitrag gesendet (manuell)",
+		FieldTitleSlackPosted:         "Slack-Beitrag gesendet",
+		FieldTitleYouTubeHighlight:    "YouTube-Highlight erstellt (manuell)",
+		FieldTitleYouTubeComment:      "Angepinnter YouTube-Kommentar hinzugefügt (manuell)",
+		FieldTitleYouTubeCommentReply: "YouTube-Kommentare beantwortet (manuell)",
+		FieldTitleGDEPosted:           "GDE-Advocu-Beitrag gesendet (manuell)",
+		FieldTitleCodeRepository:      "URL des Code-Repositorys",
+		FieldTitleNotifySponsors:      "Sponsoren benachrichtigen",
+	},
+}
+
+// FieldTitle returns the title of the field in the given locale, such as "de" or "de-DE". Locales
+// are matched case-insensitively, falling back from a regional locale to its language and, when
+// neither has a title for the field, to the English title the key is made of.
+func FieldTitle(key FieldKey, locale string) string {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	language, _, _ := strings.Cut(locale, "-")
+	for _, candidate := range []string{locale, language} {
+		if title, ok := FieldTitleSets[candidate][key]; ok {
+			return title
+		}
+	}
+	return string(key)
+}
@@ -0,0 +1,39 @@
+package constants
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      FieldKey
+		locale   string
+		expected string
+	}{
+		{name: "German override", key: FieldTitleTitle, locale: "de", expected: "Titel"},
+		{name: "Regional locale uses its language", key: FieldTitleTitle, locale: "de_DE", expected: "Titel"},
+		{name: "Locale is case-insensitive", key: FieldTitleDescription, locale: "DE-at", expected: "Beschreibung"},
+		{name: "Field missing from the set falls back to English", key: FieldTitleTags, locale: "de", expected: FieldTitleTags},
+		{name: "Missing locale falls back to English", key: FieldTitleTitle, locale: "fr", expected: FieldTitleTitle},
+		{name: "Empty locale is English", key: FieldTitleProjectName, locale: "", expected: FieldTitleProjectName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FieldTitle(tt.key, tt.locale))
+		})
+	}
+}
+
+func TestFieldTitleSets_HaveNoEmptyTitles(t *testing.T) {
+	for locale, set := range FieldTitleSets {
+		assert.Equal(t, locale, strings.ToLower(locale), "locales are keyed in lowercase")
+		for key, title := range set {
+			assert.NotEmpty(t, title, "%s title of %q", locale, key)
+		}
+	}
+}
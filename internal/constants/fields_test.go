@@ -56,14 +56,14 @@ func TestIsValidLanguage(t *testing.T) {
 			expected: false,
 		},
 		{
-			name:     "Spanish code (not supported yet)",
+			name:     "Spanish code",
 			code:     "es",
-			expected: false,
+			expected: true,
 		},
 		{
-			name:     "French code (not supported yet)",
+			name:     "French code",
 			code:     "fr",
-			expected: false,
+			expected: true,
 		},
 	}
 
@@ -0,0 +1,306 @@
+package constants
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Language constants following ISO 639-1 standard
+const (
+	// DefaultLanguage is the default language code for YouTube videos
+	DefaultLanguage = "en"
+	// LanguageEnglish is the ISO 639-1 code for English
+	LanguageEnglish = "en"
+)
+
+// LanguageMap maps ISO 639-1 language codes to their English display names
+var LanguageMap = map[string]string{
+	"aa":            "Afar",
+	"ab":            "Abkhazian",
+	"ae":            "Avestan",
+	"af":            "Afrikaans",
+	"ak":            "Akan",
+	"am":            "Amharic",
+	"an":            "Aragonese",
+	"ar":            "Arabic",
+	"as":            "Assamese",
+	"av":            "Avaric",
+	"ay":            "Aymara",
+	"az":            "Azerbaijani",
+	"ba":            "Bashkir",
+	"be":            "Belarusian",
+	"bg":            "Bulgarian",
+	"bi":            "Bislama",
+	"bm":            "Bambara",
+	"bn":            "Bengali",
+	"bo":            "Tibetan",
+	"br":            "Breton",
+	"bs":            "Bosnian",
+	"ca":            "Catalan",
+	"ce":            "Chechen",
+	"ch":            "Chamorro",
+	"co":            "Corsican",
+	"cr":            "Cree",
+	"cs":            "Czech",
+	"cu":            "Church Slavic",
+	"cv":            "Chuvash",
+	"cy":            "Welsh",
+	"da":            "Danish",
+	"de":            "German",
+	"dv":            "Divehi",
+	"dz":            "Dzongkha",
+	"ee":            "Ewe",
+	"el":            "Greek",
+	LanguageEnglish: "English",
+	"eo":            "Esperanto",
+	"es":            "Spanish",
+	"et":            "Estonian",
+	"eu":            "Basque",
+	"fa":            "Persian",
+	"ff":            "Fulah",
+	"fi":            "Finnish",
+	"fj":            "Fijian",
+	"fo":            "Faroese",
+	"fr":            "French",
+	"fy":            "Western Frisian",
+	"ga":            "Irish",
+	"gd":            "Scottish Gaelic",
+	"gl":            "Galician",
+	"gn":            "Guarani",
+	"gu":            "Gujarati",
+	"gv":            "Manx",
+	"ha":            "Hausa",
+	"he":            "Hebrew",
+	"hi":            "Hindi",
+	"ho":            "Hiri Motu",
+	"hr":            "Croatian",
+	"ht":            "Haitian",
+	"hu":            "Hungarian",
+	"hy":            "Armenian",
+	"hz":            "Herero",
+	"ia":            "Interlingua",
+	"id":            "Indonesian",
+	"ie":            "Interlingue",
+	"ig":            "Igbo",
+	"ii":            "Sichuan Yi",
+	"ik":            "Inupiaq",
+	"io":            "Ido",
+	"is":            "Icelandic",
+	"it":            "Italian",
+	"iu":            "Inuktitut",
+	"ja":            "Japanese",
+	"jv":            "Javanese",
+	"ka":            "Georgian",
+	"kg":            "Kongo",
+	"ki":            "Kikuyu",
+	"kj":            "Kuanyama",
+	"kk":            "Kazakh",
+	"kl":            "Kalaallisut",
+	"km":            "Khmer",
+	"kn":            "Kannada",
+	"ko":            "Korean",
+	"kr":            "Kanuri",
+	"ks":            "Kashmiri",
+	"ku":            "Kurdish",
+	"kv":            "Komi",
+	"kw":            "Cornish",
+	"ky":            "Kyrgyz",
+	"la":            "Latin",
+	"lb":            "Luxembourgish",
+	"lg":            "Ganda",
+	"li":            "Limburgish",
+	"ln":            "Lingala",
+	"lo":            "Lao",
+	"lt":            "Lithuanian",
+	"lu":            "Luba-Katanga",
+	"lv":            "Latvian",
+	"mg":            "Malagasy",
+	"mh":            "Marshallese",
+	"mi":            "Maori",
+	"mk":            "Macedonian",
+	"ml":            "Malayalam",
+	"mn":            "Mongolian",
+	"mr":            "Marathi",
+	"ms":            "Malay",
+	"mt":            "Maltese",
+	"my":            "Burmese",
+	"na":            "Nauru",
+	"nb":            "Norwegian Bokmål",
+	"nd":            "North Ndebele",
+	"ne":            "Nepali",
+	"ng":            "Ndonga",
+	"nl":            "Dutch",
+	"nn":            "Norwegian Nynorsk",
+	"no":            "Norwegian",
+	"nr":            "South Ndebele",
+	"nv":            "Navajo",
+	"ny":            "Chichewa",
+	"oc":            "Occitan",
+	"oj":            "Ojibwa",
+	"om":            "Oromo",
+	"or":            "Oriya",
+	"os":            "Ossetian",
+	"pa":            "Punjabi",
+	"pi":            "Pali",
+	"pl":            "Polish",
+	"ps":            "Pashto",
+	"pt":            "Portuguese",
+	"qu":            "Quechua",
+	"rm":            "Romansh",
+	"rn":            "Rundi",
+	"ro":            "Romanian",
+	"ru":            "Russian",
+	"rw":            "Kinyarwanda",
+	"sa":            "Sanskrit",
+	"sc":            "Sardinian",
+	"sd":            "Sindhi",
+	"se":            "Northern Sami",
+	"sg":            "Sango",
+	"si":            "Sinhala",
+	"sk":            "Slovak",
+	"sl":            "Slovenian",
+	"sm":            "Samoan",
+	"sn":            "Shona",
+	"so":            "Somali",
+	"sq":            "Albanian",
+	"sr":            "Serbian",
+	"ss":            "Swati",
+	"st":            "Southern Sotho",
+	"su":            "Sundanese",
+	"sv":            "Swedish",
+	"sw":            "Swahili",
+	"ta":            "Tamil",
+	"te":            "Telugu",
+	"tg":            "Tajik",
+	"th":            "Thai",
+	"ti":            "Tigrinya",
+	"tk":            "Turkmen",
+	"tl":            "Tagalog",
+	"tn":            "Tswana",
+	"to":            "Tonga",
+	"tr":            "Turkish",
+	"ts":            "Tsonga",
+	"tt":            "Tatar",
+	"tw":            "Twi",
+	"ty":            "Tahitian",
+	"ug":            "Uyghur",
+	"uk":            "Ukrainian",
+	"ur":            "Urdu",
+	"uz":            "Uzbek",
+	"ve":            "Venda",
+	"vi":            "Vietnamese",
+	"vo":            "Volapük",
+	"wa":            "Walloon",
+	"wo":            "Wolof",
+	"xh":            "Xhosa",
+	"yi":            "Yiddish",
+	"yo":            "Yoruba",
+	"za":            "Zhuang",
+	"zh":            "Chinese",
+	"zu":            "Zulu",
+}
+
+// IsValidLanguage checks if a language code is valid according to our supported languages.
+// Regional and script variants in BCP-47 form (e.g. "pt-BR", "zh-Hant", "es-419") are
+// accepted as-is when their primary subtag is a supported ISO 639-1 code, since YouTube
+// accepts those tags directly.
+// Codes are normalized with NormalizeLanguage before lookup, so "EN" and " en " are accepted.
+func IsValidLanguage(code string) bool {
+	primary, rest, hasSubtags := strings.Cut(NormalizeLanguage(code), "-")
+	if _, exists := LanguageMap[primary]; !exists {
+		return false
+	}
+	if !hasSubtags {
+		return true
+	}
+	for _, subtag := range strings.Split(rest, "-") {
+		if !isValidSubtag(subtag) {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeLanguage trims surrounding whitespace and applies BCP-47 casing conventions:
+// the primary language subtag is lowercased, region subtags are uppercased ("pt-BR")
+// and script subtags are title-cased ("zh-Hant"). Underscores are treated as separators.
+func NormalizeLanguage(code string) string {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return ""
+	}
+	subtags := strings.Split(strings.ReplaceAll(code, "_", "-"), "-")
+	subtags[0] = strings.ToLower(subtags[0])
+	for i := 1; i < len(subtags); i++ {
+		switch len(subtags[i]) {
+		case 2:
+			subtags[i] = strings.ToUpper(subtags[i])
+		case 4:
+			subtags[i] = strings.ToUpper(subtags[i][:1]) + strings.ToLower(subtags[i][1:])
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+// LanguageName returns the English display name for a language code and whether it exists.
+// Codes are normalized like in IsValidLanguage, so "EN" is English, and regional or script
+// variants such as "en-US" are named after their primary language.
+func LanguageName(code string) (string, bool) {
+	if !IsValidLanguage(code) {
+		return "", false
+	}
+	primary, _, _ := strings.Cut(NormalizeLanguage(code), "-")
+	return LanguageMap[primary], true
+}
+
+var (
+	sortedLanguagesOnce sync.Once
+	sortedLanguages     []string
+)
+
+// AllLanguages returns the supported language codes in ascending order.
+// The sorted list is computed once; each call only copies it, so it is cheap
+// enough to call from form callbacks on every keystroke.
+func AllLanguages() []string {
+	sortedLanguagesOnce.Do(func() {
+		sortedLanguages = make([]string, 0, len(LanguageMap))
+		for code := range LanguageMap {
+			sortedLanguages = append(sortedLanguages, code)
+		}
+		sort.Strings(sortedLanguages)
+	})
+	return slices.Clone(sortedLanguages)
+}
+
+// isValidSubtag reports whether a subtag is a BCP-47 region (two letters or three digits)
+// or script (four letters) subtag.
+func isValidSubtag(subtag string) bool {
+	switch len(subtag) {
+	case 2, 4:
+		return isAllLetters(subtag)
+	case 3:
+		return isAllDigits(subtag)
+	default:
+		return false
+	}
+}
+
+func isAllLetters(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAllDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
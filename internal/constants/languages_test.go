@@ -0,0 +1,125 @@
+package constants
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguageMapCoversISO6391(t *testing.T) {
+	resolved := 0
+	for code := range LanguageMap {
+		if IsValidLanguage(code) {
+			resolved++
+		}
+	}
+	assert.GreaterOrEqual(t, resolved, 180, "LanguageMap should cover the full ISO 639-1 set")
+
+	assert.Equal(t, "Spanish", LanguageMap["es"])
+	assert.Equal(t, "German", LanguageMap["de"])
+	assert.Equal(t, "Japanese", LanguageMap["ja"])
+
+	for code := range LanguageMap {
+		assert.Len(t, code, 2, "LanguageMap key '%s' should be a two-letter ISO 639-1 code", code)
+	}
+}
+
+func TestIsValidLanguageRegionalVariants(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected bool
+	}{
+		{name: "Brazilian Portuguese", code: "pt-BR", expected: true},
+		{name: "British English", code: "en-GB", expected: true},
+		{name: "Latin American Spanish", code: "es-419", expected: true},
+		{name: "Traditional Chinese script", code: "zh-Hant", expected: true},
+		{name: "Script and region", code: "zh-Hant-TW", expected: true},
+		{name: "Unknown primary subtag", code: "xx-BR", expected: false},
+		{name: "Malformed region subtag", code: "pt-BRA", expected: false},
+		{name: "Trailing separator", code: "pt-", expected: false},
+		{name: "Leading separator", code: "-BR", expected: false},
+		{name: "Three-letter code", code: "eng", expected: false},
+		{name: "Empty string", code: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsValidLanguage(tt.code), "IsValidLanguage(%s) should return %v", tt.code, tt.expected)
+		})
+	}
+}
+
+func TestLanguageName(t *testing.T) {
+	tests := []struct {
+		name         string
+		code         string
+		expectedName string
+		expectedOK   bool
+	}{
+		{name: "English", code: "en", expectedName: "English", expectedOK: true},
+		{name: "German", code: "de", expectedName: "German", expectedOK: true},
+		{name: "Uppercase code", code: "EN", expectedName: "English", expectedOK: true},
+		{name: "Regional variant", code: "en-US", expectedName: "English", expectedOK: true},
+		{name: "Underscore separator", code: " pt_br ", expectedName: "Portuguese", expectedOK: true},
+		{name: "Invalid subtag", code: "en-x", expectedName: "", expectedOK: false},
+		{name: "Unknown code", code: "xx", expectedName: "", expectedOK: false},
+		{name: "Empty string", code: "", expectedName: "", expectedOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := LanguageName(tt.code)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedName, name)
+		})
+	}
+}
+
+func TestAllLanguages(t *testing.T) {
+	codes := AllLanguages()
+
+	assert.Len(t, codes, len(LanguageMap), "AllLanguages should return every supported code")
+	assert.True(t, sort.StringsAreSorted(codes), "AllLanguages should return codes in ascending order")
+	assert.Contains(t, codes, LanguageEnglish)
+
+	// Mutating the returned slice must not affect subsequent calls
+	codes[0] = "mutated"
+	assert.NotEqual(t, "mutated", AllLanguages()[0])
+}
+
+func TestNormalizeLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{name: "Empty string", code: "", expected: ""},
+		{name: "Whitespace only", code: "   ", expected: ""},
+		{name: "Already normalized", code: "en", expected: "en"},
+		{name: "Uppercase", code: "EN", expected: "en"},
+		{name: "Mixed case", code: "En", expected: "en"},
+		{name: "Surrounding whitespace", code: " en ", expected: "en"},
+		{name: "Region subtag casing", code: "PT-br", expected: "pt-BR"},
+		{name: "Script subtag casing", code: "zh-hANT", expected: "zh-Hant"},
+		{name: "Numeric region", code: "ES-419", expected: "es-419"},
+		{name: "Underscore separator", code: "pt_BR", expected: "pt-BR"},
+		{name: "Bogus code keeps shape", code: " Invalid ", expected: "invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeLanguage(tt.code))
+		})
+	}
+}
+
+func TestIsValidLanguageNormalizesInput(t *testing.T) {
+	assert.True(t, IsValidLanguage("EN"))
+	assert.True(t, IsValidLanguage(" en "))
+	assert.True(t, IsValidLanguage("En"))
+	assert.True(t, IsValidLanguage("pt-br"))
+	assert.False(t, IsValidLanguage("  "))
+	assert.False(t, IsValidLanguage("INVALID"))
+}
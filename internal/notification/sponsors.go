@@ -0,0 +1,72 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+
+	"devopstoolkit/youtube-automation/internal/configuration"
+	"devopstoolkit/youtube-automation/internal/storage"
+)
+
+// Mailer sends a single email message. *Email satisfies it.
+type Mailer interface {
+	Send(from string, to []string, subject, body string, attachmentPath string) error
+}
+
+// sponsorNotificationTemplate is the body sent to each sponsor once their video is released.
+var sponsorNotificationTemplate = template.Must(template.New("sponsor").Parse(`Hi,
+<br><br>The video "{{.Title}}" has just been released and is available at {{.Link}}. Please let me know what you think or if you have any questions.
+<br><br>I'll send the invoice for {{.Amount}} in a separate message.
+`))
+
+// sponsorNotification holds the values rendered into sponsorNotificationTemplate.
+type sponsorNotification struct {
+	Title  string
+	Link   string
+	Amount string
+}
+
+// NotifySponsors emails every address in the video's sponsorship, one message per recipient,
+// and returns the addresses that were sent successfully. Nothing is sent when the sponsorship
+// is blocked. Failures for individual recipients don't stop the others; they are joined into
+// the returned error. Cancelling ctx stops before the next recipient.
+func NotifySponsors(ctx context.Context, mailer Mailer, video *storage.Video) (sent []string, err error) {
+	if video == nil || video.Sponsorship.IsBlocked() {
+		return nil, nil
+	}
+	recipients := video.Sponsorship.EmailList()
+	if len(recipients) == 0 {
+		return nil, nil
+	}
+	if video.VideoId == "" {
+		return nil, fmt.Errorf("video ID is required to notify sponsors")
+	}
+
+	subject := fmt.Sprintf("DevOps Toolkit Video Sponsorship - %s", video.Title)
+	var body bytes.Buffer
+	err = sponsorNotificationTemplate.Execute(&body, sponsorNotification{
+		Title:  video.Title,
+		Link:   fmt.Sprintf("https://youtu.be/%s", video.VideoId),
+		Amount: video.Sponsorship.Amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render sponsor notification: %w", err)
+	}
+
+	var errs []error
+	for _, recipient := range recipients {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			errs = append(errs, ctxErr)
+			break
+		}
+		if sendErr := mailer.Send(configuration.GlobalSettings.Email.From, []string{recipient}, subject, body.String(), ""); sendErr != nil {
+			errs = append(errs, fmt.Errorf("failed to notify %s: %w", storage.RedactEmail(recipient), sendErr))
+			continue
+		}
+		sent = append(sent, recipient)
+	}
+	return sent, errors.Join(errs...)
+}
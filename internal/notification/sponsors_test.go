@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMailer records sent messages and fails for the configured recipients.
+type fakeMailer struct {
+	failFor  map[string]bool
+	messages []fakeMessage
+}
+
+type fakeMessage struct {
+	to      []string
+	subject string
+	body    string
+}
+
+func (m *fakeMailer) Send(from string, to []string, subject, body string, attachmentPath string) error {
+	if m.failFor[to[0]] {
+		return errors.New("mailbox unavailable")
+	}
+	m.messages = append(m.messages, fakeMessage{to: to, subject: subject, body: body})
+	return nil
+}
+
+func sponsoredVideo() *storage.Video {
+	return &storage.Video{
+		VideoId: "dQw4w9WgXcQ",
+		Title:   "Kubernetes & Friends",
+		Sponsorship: storage.Sponsorship{
+			Amount: "$1000",
+			Emails: "a@example.com, b@example.com, A@example.com",
+		},
+	}
+}
+
+func TestNotifySponsors_SendsPerRecipient(t *testing.T) {
+	mailer := &fakeMailer{}
+
+	sent, err := NotifySponsors(context.Background(), mailer, sponsoredVideo())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, sent)
+	require.Len(t, mailer.messages, 2)
+	assert.Equal(t, []string{"a@example.com"}, mailer.messages[0].to)
+	assert.Equal(t, "DevOps Toolkit Video Sponsorship - Kubernetes & Friends", mailer.messages[0].subject)
+	assert.Contains(t, mailer.messages[0].body, "https://youtu.be/dQw4w9WgXcQ")
+	assert.Contains(t, mailer.messages[0].body, "Kubernetes &amp; Friends")
+	assert.Contains(t, mailer.messages[0].body, "$1000")
+}
+
+func TestNotifySponsors_PartialFailure(t *testing.T) {
+	mailer := &fakeMailer{failFor: map[string]bool{"a@example.com": true}}
+
+	sent, err := NotifySponsors(context.Background(), mailer, sponsoredVideo())
+	require.Error(t, err)
+
+	assert.Equal(t, []string{"b@example.com"}, sent)
+	assert.Contains(t, err.Error(), "a***@example.com", "failed recipients are reported redacted")
+	assert.NotContains(t, err.Error(), "a@example.com")
+	assert.NotContains(t, err.Error(), "b***@example.com")
+}
+
+func TestNotifySponsors_Skips(t *testing.T) {
+	tests := []struct {
+		name  string
+		video *storage.Video
+	}{
+		{name: "nil video"},
+		{name: "blocked", video: &storage.Video{VideoId: "abc", Sponsorship: storage.Sponsorship{Emails: "a@example.com", Blocked: "yes"}}},
+		{name: "no emails", video: &storage.Video{VideoId: "abc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mailer := &fakeMailer{}
+			sent, err := NotifySponsors(context.Background(), mailer, tt.video)
+			assert.NoError(t, err)
+			assert.Empty(t, sent)
+			assert.Empty(t, mailer.messages)
+		})
+	}
+}
+
+func TestNotifySponsors_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mailer := &fakeMailer{}
+
+	sent, err := NotifySponsors(ctx, mailer, sponsoredVideo())
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, sent)
+	assert.Empty(t, mailer.messages)
+}
+
+func TestEmailImplementsMailer(t *testing.T) {
+	var _ Mailer = NewEmail("password")
+}
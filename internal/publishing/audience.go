@@ -0,0 +1,30 @@
+package publishing
+
+import (
+	"slices"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"google.golang.org/api/youtube/v3"
+)
+
+// ApplyAudience sets the COPPA audience declaration YouTube requires on the YouTube video
+// object: video.MadeForKids when set, defaultMadeForKids otherwise. The flag is always sent,
+// even when false, so YouTube never has to ask for it. A nil youtubeVideo is left alone.
+func ApplyAudience(youtubeVideo *youtube.Video, video *storage.Video, defaultMadeForKids bool) {
+	if youtubeVideo == nil {
+		return
+	}
+	madeForKids := defaultMadeForKids
+	if video != nil && video.MadeForKids != nil {
+		madeForKids = *video.MadeForKids
+	}
+
+	if youtubeVideo.Status == nil {
+		youtubeVideo.Status = &youtube.VideoStatus{}
+	}
+	youtubeVideo.Status.SelfDeclaredMadeForKids = madeForKids
+	// The field is omitted when false unless forced, which would leave the audience undeclared
+	if !slices.Contains(youtubeVideo.Status.ForceSendFields, "SelfDeclaredMadeForKids") {
+		youtubeVideo.Status.ForceSendFields = append(youtubeVideo.Status.ForceSendFields, "SelfDeclaredMadeForKids")
+	}
+}
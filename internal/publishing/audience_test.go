@@ -0,0 +1,55 @@
+package publishing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/youtube/v3"
+)
+
+func TestApplyAudience(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		name               string
+		madeForKids        *bool
+		defaultMadeForKids bool
+		expected           bool
+	}{
+		{name: "Explicitly made for kids", madeForKids: &yes, expected: true},
+		{name: "Explicitly not made for kids overrides the default", madeForKids: &no, defaultMadeForKids: true, expected: false},
+		{name: "Unset uses the default", defaultMadeForKids: true, expected: true},
+		{name: "Unset with default not made for kids", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			youtubeVideo := &youtube.Video{}
+			ApplyAudience(youtubeVideo, &storage.Video{MadeForKids: tt.madeForKids}, tt.defaultMadeForKids)
+
+			require.NotNil(t, youtubeVideo.Status, "a missing status is created")
+			assert.Equal(t, tt.expected, youtubeVideo.Status.SelfDeclaredMadeForKids)
+		})
+	}
+}
+
+func TestApplyAudience_SendsFalse(t *testing.T) {
+	youtubeVideo := &youtube.Video{Status: &youtube.VideoStatus{PrivacyStatus: PrivacyPublic}}
+	ApplyAudience(youtubeVideo, &storage.Video{}, false)
+	ApplyAudience(youtubeVideo, &storage.Video{}, false)
+
+	data, err := json.Marshal(youtubeVideo.Status)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"privacyStatus": "public", "selfDeclaredMadeForKids": false}`, string(data))
+	assert.Len(t, youtubeVideo.Status.ForceSendFields, 1, "applying twice doesn't repeat the forced field")
+}
+
+func TestApplyAudience_NilSafe(t *testing.T) {
+	assert.NotPanics(t, func() { ApplyAudience(nil, &storage.Video{}, true) })
+
+	youtubeVideo := &youtube.Video{}
+	ApplyAudience(youtubeVideo, nil, true)
+	assert.True(t, youtubeVideo.Status.SelfDeclaredMadeForKids, "missing metadata uses the default")
+}
@@ -0,0 +1,111 @@
+package publishing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Operations recorded in the audit log
+const (
+	AuditOperationUpload    = "upload"
+	AuditOperationUpdate    = "update"
+	AuditOperationCaption   = "caption"
+	AuditOperationThumbnail = "thumbnail"
+	AuditOperationComment   = "comment"
+)
+
+// AuditRecord is one line of the audit log.
+type AuditRecord struct {
+	Time      time.Time `json:"time"`
+	Operation string    `json:"operation"`
+	VideoID   string    `json:"videoId,omitempty"`
+	Success   bool      `json:"success"`
+	ErrorType ErrorType `json:"errorType,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// AuditLogger appends one JSON record per YouTube operation to a file, as a durable trail
+// kept independently of the regular logs. It is safe for concurrent use.
+type AuditLogger struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// NewAuditLogger opens the audit log at path for appending, creating it if needed.
+func NewAuditLogger(path string) (*AuditLogger, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", path, err)
+	}
+	return &AuditLogger{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// Record appends a record of operation on the video videoID, failed when err is not nil.
+func (a *AuditLogger) Record(operation, videoID string, err error) error {
+	record := AuditRecord{
+		Time:      clock.Now().UTC(),
+		Operation: operation,
+		VideoID:   videoID,
+		Success:   err == nil,
+	}
+	if err != nil {
+		record.Error = err.Error()
+		var yErr *YouTubeError
+		if errors.As(err, &yErr) {
+			record.ErrorType = yErr.Type
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// Each record is a single write, so lines of concurrent processes don't interleave either
+	if err := a.enc.Encode(record); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	return nil
+}
+
+// Sync commits the records written so far to stable storage.
+func (a *AuditLogger) Sync() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := a.file.Sync(); err != nil {
+		return fmt.Errorf("failed to flush audit log: %w", err)
+	}
+	return nil
+}
+
+// Close closes the audit log file.
+func (a *AuditLogger) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.file.Close()
+}
+
+// auditLogger receives a record of every upload, update, caption and thumbnail operation;
+// nothing is audited while it is nil.
+var auditLogger atomic.Pointer[AuditLogger]
+
+// SetAuditLogger makes the publishing operations record themselves in a, in addition to the
+// regular logs. A nil logger turns auditing off.
+func SetAuditLogger(a *AuditLogger) {
+	auditLogger.Store(a)
+}
+
+// audit records the operation in the audit log, if one is set. Failing to write the record
+// is logged rather than failing the operation, which already happened.
+func audit(operation, videoID string, err error) {
+	a := auditLogger.Load()
+	if a == nil {
+		return
+	}
+	if recordErr := a.Record(operation, videoID, err); recordErr != nil {
+		LogYouTubeWarn("Failed to audit %s of video ID %s: %v", operation, videoID, recordErr)
+	}
+}
@@ -0,0 +1,159 @@
+package publishing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useAuditLog audits publishing operations to a temporary file for the duration of a test and
+// returns the file's path.
+func useAuditLog(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	logger, err := NewAuditLogger(path)
+	require.NoError(t, err)
+	SetAuditLogger(logger)
+	t.Cleanup(func() {
+		SetAuditLogger(nil)
+		logger.Close()
+	})
+	return path
+}
+
+// readAuditRecords parses the audit log at path, failing the test on any malformed line.
+func readAuditRecords(t *testing.T, path string) []AuditRecord {
+	t.Helper()
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []AuditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record AuditRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record), "malformed audit line %q", scanner.Text())
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestAudit_RecordsOperations(t *testing.T) {
+	fake := useFakeClock(t)
+	path := useAuditLog(t)
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/upload/youtube/v3/videos":
+			w.Write([]byte(`{"id": "dQw4w9WgXcQ"}`))
+		case r.URL.Path == "/youtube/v3/videos" && r.Method == http.MethodGet:
+			w.Write([]byte(existingVideoJSON))
+		case r.URL.Path == "/upload/youtube/v3/captions":
+			w.Write([]byte(`{"id": "caption-123"}`))
+		case r.URL.Path == "/youtube/v3/videos":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error": {"code": 403, "message": "forbidden", "errors": [{"reason": "forbidden"}]}}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error": {"code": 500, "message": "backend error"}}`))
+		}
+	})
+	ctx := context.Background()
+
+	video := &storage.Video{Title: "Title", UploadVideo: writeTestVideoFile(t, 100)}
+	_, err := UploadVideo(ctx, service, video, PublishOptions{})
+	require.NoError(t, err)
+	assert.Error(t, UpdateVideoMetadata(ctx, service, video))
+	_, err = UploadCaption(ctx, service, video.VideoId, "en", writeTestSRT(t))
+	require.NoError(t, err)
+	assert.Error(t, SetThumbnail(ctx, service, video.VideoId, writeTestImage(t, "thumbnail.png", 1280, 720)))
+
+	records := readAuditRecords(t, path)
+	require.Len(t, records, 4, "one record per operation")
+	for _, record := range records {
+		assert.True(t, record.Time.Equal(fake.Now()), "records are timestamped with the clock")
+		assert.Equal(t, "dQw4w9WgXcQ", record.VideoID)
+	}
+	assert.Equal(t, []string{AuditOperationUpload, AuditOperationUpdate, AuditOperationCaption, AuditOperationThumbnail},
+		[]string{records[0].Operation, records[1].Operation, records[2].Operation, records[3].Operation})
+	assert.True(t, records[0].Success)
+	assert.True(t, records[2].Success)
+	assert.Empty(t, records[0].Error)
+
+	assert.False(t, records[1].Success)
+	assert.Equal(t, ErrorTypeAuth, records[1].ErrorType)
+	assert.NotEmpty(t, records[1].Error)
+	assert.False(t, records[3].Success)
+	assert.Equal(t, ErrorTypeServer, records[3].ErrorType)
+}
+
+func TestAudit_SkipsDryRun(t *testing.T) {
+	path := useAuditLog(t)
+	enableDryRun(t)
+
+	require.NoError(t, SetThumbnail(context.Background(), nil, "dQw4w9WgXcQ", "thumbnail.png"))
+	assert.Empty(t, readAuditRecords(t, path), "nothing happened, so there is nothing to audit")
+}
+
+func TestAuditLogger_Concurrent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	logger, err := NewAuditLogger(path)
+	require.NoError(t, err)
+
+	const writers = 50
+	var wg sync.WaitGroup
+	for i := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, logger.Record(AuditOperationUpload, fmt.Sprintf("video-%d", i), nil))
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, logger.Close())
+
+	records := readAuditRecords(t, path)
+	require.Len(t, records, writers)
+	seen := make(map[string]bool)
+	for _, record := range records {
+		seen[record.VideoID] = true
+	}
+	assert.Len(t, seen, writers, "every record must be written whole")
+}
+
+func TestAuditLogger_Appends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	for range 2 {
+		logger, err := NewAuditLogger(path)
+		require.NoError(t, err)
+		require.NoError(t, logger.Record(AuditOperationCaption, "dQw4w9WgXcQ", errors.New("quota exceeded")))
+		require.NoError(t, logger.Close())
+	}
+
+	assert.Len(t, readAuditRecords(t, path), 2, "reopening the log must keep earlier records")
+}
+
+func TestAuditRecord_JSONFields(t *testing.T) {
+	record := AuditRecord{Operation: AuditOperationUpload, VideoID: "dQw4w9WgXcQ", ErrorType: ErrorTypeRateLimit, Error: "rate limit exceeded"}
+
+	data, err := json.Marshal(record)
+	require.NoError(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(data, &fields))
+	assert.ElementsMatch(t, []string{"time", "operation", "videoId", "success", "errorType", "error"}, slices.Collect(maps.Keys(fields)))
+}
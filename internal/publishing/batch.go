@@ -0,0 +1,98 @@
+package publishing
+
+import (
+	"context"
+	"sync"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"google.golang.org/api/youtube/v3"
+)
+
+// batchUpload uploads a single video of a batch, replaceable for testing
+var batchUpload = UploadVideo
+
+// BatchOutcome is the result of one video of an UploadBatch.
+type BatchOutcome struct {
+	Video   *storage.Video
+	VideoID string        // ID of the uploaded video, empty unless the upload succeeded
+	Err     *YouTubeError // Why the upload failed, nil if it succeeded or was skipped
+	Skipped bool          // Whether the upload never started because the context was cancelled
+}
+
+// BatchResult aggregates the outcomes of an UploadBatch.
+type BatchResult struct {
+	Succeeded int
+	Failed    int
+	Skipped   int
+	Outcomes  []BatchOutcome // One per video, in the order the videos were given
+}
+
+// UploadBatch uploads the videos with UploadVideo, at most maxConcurrent at a time (one when
+// maxConcurrent is below one). Once ctx is cancelled no new uploads start and the remaining
+// videos are reported as skipped, while uploads already in flight are allowed to finish. config,
+// which may be nil, applies to every upload like PublishOptions.Config.
+func UploadBatch(ctx context.Context, service *youtube.Service, videos []*storage.Video, maxConcurrent int, config *PublishConfig) BatchResult {
+	maxConcurrent = max(maxConcurrent, 1)
+	result := BatchResult{Outcomes: make([]BatchOutcome, len(videos))}
+	// In-flight uploads must not be aborted by the cancellation that stops the batch
+	uploadCtx := context.WithoutCancel(ctx)
+
+	semaphore := make(chan struct{}, maxConcurrent)
+	var wg sync.WaitGroup
+	for i, video := range videos {
+		outcome := &result.Outcomes[i]
+		outcome.Video = video
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			for j := i; j < len(videos); j++ {
+				result.Outcomes[j].Video = videos[j]
+				result.Outcomes[j].Skipped = true
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			videoID, err := batchUpload(uploadCtx, service, video, PublishOptions{Config: config})
+			if err != nil {
+				outcome.Err = CategorizeError(err)
+				return
+			}
+			outcome.VideoID = videoID
+		}()
+	}
+	wg.Wait()
+
+	for _, outcome := range result.Outcomes {
+		switch {
+		case outcome.Skipped:
+			result.Skipped++
+		case outcome.Err != nil:
+			result.Failed++
+		default:
+			result.Succeeded++
+		}
+	}
+	return result
+}
+
+// Err returns the failures of the batch as a *MultiError, or nil when no upload failed.
+// Skipped videos are not errors.
+func (r BatchResult) Err() error {
+	var errs []*YouTubeError
+	for _, outcome := range r.Outcomes {
+		if outcome.Err != nil {
+			errs = append(errs, outcome.Err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: errs}
+}
@@ -0,0 +1,99 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+// useBatchUploader replaces the function UploadBatch uses to upload each video.
+func useBatchUploader(t *testing.T, upload func(ctx context.Context, service *youtube.Service, video *storage.Video, opts PublishOptions) (string, error)) {
+	t.Helper()
+	original := batchUpload
+	batchUpload = upload
+	t.Cleanup(func() {
+		batchUpload = original
+	})
+}
+
+func testVideos(n int) []*storage.Video {
+	videos := make([]*storage.Video, n)
+	for i := range videos {
+		videos[i] = &storage.Video{Name: fmt.Sprintf("video-%d", i)}
+	}
+	return videos
+}
+
+func TestUploadBatch_LimitsConcurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	useBatchUploader(t, func(ctx context.Context, service *youtube.Service, video *storage.Video, opts PublishOptions) (string, error) {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		if video.Name == "video-3" {
+			return "", &googleapi.Error{Code: http.StatusServiceUnavailable, Message: "backend unavailable"}
+		}
+		return "id-" + video.Name, nil
+	})
+
+	videos := testVideos(10)
+	result := UploadBatch(context.Background(), nil, videos, 3, nil)
+
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+	assert.Equal(t, 9, result.Succeeded)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, 0, result.Skipped)
+	require.Len(t, result.Outcomes, 10)
+	for i, outcome := range result.Outcomes {
+		assert.Same(t, videos[i], outcome.Video, "outcomes keep the order of the videos")
+	}
+	assert.Equal(t, "id-video-0", result.Outcomes[0].VideoID)
+	require.NotNil(t, result.Outcomes[3].Err)
+	assert.Equal(t, ErrorTypeServer, result.Outcomes[3].Err.Type)
+	assert.Empty(t, result.Outcomes[3].VideoID)
+}
+
+func TestUploadBatch_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	useBatchUploader(t, func(uploadCtx context.Context, service *youtube.Service, video *storage.Video, opts PublishOptions) (string, error) {
+		once.Do(func() { close(started) })
+		<-release
+		if err := uploadCtx.Err(); err != nil {
+			return "", fmt.Errorf("network error: %w", err)
+		}
+		return "id-" + video.Name, nil
+	})
+
+	go func() {
+		<-started
+		cancel()
+		close(release)
+	}()
+	result := UploadBatch(ctx, nil, testVideos(5), 1, nil)
+
+	assert.Equal(t, 1, result.Succeeded, "the in-flight upload finishes despite the cancellation")
+	assert.Equal(t, 0, result.Failed)
+	assert.Equal(t, 4, result.Skipped)
+	assert.Equal(t, "id-video-0", result.Outcomes[0].VideoID)
+	for _, outcome := range result.Outcomes[1:] {
+		assert.True(t, outcome.Skipped)
+		assert.NotNil(t, outcome.Video)
+	}
+}
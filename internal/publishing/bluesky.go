@@ -0,0 +1,199 @@
+package publishing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"google.golang.org/api/googleapi"
+)
+
+// MaxBlueSkyPostLength is the maximum number of characters BlueSky accepts in a post.
+const MaxBlueSkyPostLength = 300
+
+// blueSkyLinkPlaceholder is the marker used in tweets for the manual posting workflow.
+const blueSkyLinkPlaceholder = "[YOUTUBE]"
+
+// BlueSkyConfig holds the account and endpoint used by PostToBlueSky.
+type BlueSkyConfig struct {
+	Identifier  string       // Handle or email of the account, e.g. username.bsky.social
+	AppPassword string       // App password created in the BlueSky settings
+	URL         string       // XRPC base URL, e.g. https://bsky.social/xrpc
+	HTTPClient  *http.Client // Client used for all requests, http.DefaultClient when nil
+}
+
+type blueSkySession struct {
+	AccessJWT string `json:"accessJwt"`
+	DID       string `json:"did"`
+}
+
+type blueSkyFacet struct {
+	Index    blueSkyByteSlice     `json:"index"`
+	Features []blueSkyLinkFeature `json:"features"`
+}
+
+type blueSkyByteSlice struct {
+	ByteStart int `json:"byteStart"`
+	ByteEnd   int `json:"byteEnd"`
+}
+
+type blueSkyLinkFeature struct {
+	Type string `json:"$type"`
+	URI  string `json:"uri"`
+}
+
+type blueSkyPostRecord struct {
+	Type      string         `json:"$type"`
+	Text      string         `json:"text"`
+	Facets    []blueSkyFacet `json:"facets,omitempty"`
+	CreatedAt string         `json:"createdAt"`
+}
+
+type blueSkyCreateRecordRequest struct {
+	Repo       string            `json:"repo"`
+	Collection string            `json:"collection"`
+	Record     blueSkyPostRecord `json:"record"`
+}
+
+// PostToBlueSky publishes the video's tweet on BlueSky and returns the AT URI of the new post.
+// It signs in with an app password, appends the YouTube link (replacing the [YOUTUBE]
+// placeholder used by the manual workflow) and shortens the text on a word boundary so the
+// post, link included, fits the 300-character limit. Failures are returned as a categorized
+// *YouTubeError.
+func PostToBlueSky(ctx context.Context, cfg BlueSkyConfig, video *storage.Video) (postURI string, err error) {
+	if video != nil && skipForDryRun("post video ID %s to BlueSky: %q", video.VideoId, composeBlueSkyText(video.Tweet, GetYouTubeURL(video.VideoId))) {
+		return "", nil
+	}
+
+	defer func() {
+		if err != nil {
+			YouTubeMetrics.IncBlueSkyPostFailure()
+		} else {
+			YouTubeMetrics.IncBlueSkyPostSuccess()
+		}
+	}()
+
+	if video == nil || video.VideoId == "" {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "video ID is required to post to BlueSky"}
+	}
+	if strings.TrimSpace(video.Tweet) == "" {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "tweet is required to post to BlueSky", VideoID: video.VideoId}
+	}
+	if cfg.Identifier == "" || cfg.AppPassword == "" {
+		return "", &YouTubeError{Type: ErrorTypeAuth, Message: "BlueSky identifier and app password are required", VideoID: video.VideoId}
+	}
+
+	link := GetYouTubeURL(video.VideoId)
+	record := blueSkyPostRecord{
+		Type:      "app.bsky.feed.post",
+		Text:      composeBlueSkyText(video.Tweet, link),
+		CreatedAt: clock.Now().UTC().Format("2006-01-02T15:04:05.000Z"),
+	}
+	start := strings.LastIndex(record.Text, link)
+	record.Facets = []blueSkyFacet{{
+		Index:    blueSkyByteSlice{ByteStart: start, ByteEnd: start + len(link)},
+		Features: []blueSkyLinkFeature{{Type: "app.bsky.richtext.facet#link", URI: link}},
+	}}
+
+	var session blueSkySession
+	login := map[string]string{"identifier": cfg.Identifier, "password": cfg.AppPassword}
+	if err := blueSkyCall(ctx, cfg, "com.atproto.server.createSession", "", login, &session); err != nil {
+		return "", blueSkyError(err, video.VideoId, "Failed to authenticate with BlueSky")
+	}
+
+	var created struct {
+		URI string `json:"uri"`
+	}
+	request := blueSkyCreateRecordRequest{Repo: session.DID, Collection: "app.bsky.feed.post", Record: record}
+	if err := blueSkyCall(ctx, cfg, "com.atproto.repo.createRecord", session.AccessJWT, request, &created); err != nil {
+		return "", blueSkyError(err, video.VideoId, "Failed to create BlueSky post")
+	}
+	if created.URI == "" {
+		return "", blueSkyError(fmt.Errorf("invalid BlueSky response: missing post URI"), video.VideoId, "Failed to create BlueSky post")
+	}
+
+	LogYouTubeInfo("Posted video ID %s to BlueSky as %s", video.VideoId, created.URI)
+	return created.URI, nil
+}
+
+// composeBlueSkyText builds the post text from a tweet and the video link. The link is always
+// appended at the end, so truncation only ever shortens the tweet itself.
+func composeBlueSkyText(tweet, link string) string {
+	text := strings.TrimSpace(strings.ReplaceAll(tweet, blueSkyLinkPlaceholder, ""))
+	suffix := "\n\n" + link
+	return truncateOnWord(text, MaxBlueSkyPostLength-utf8.RuneCountInString(suffix)) + suffix
+}
+
+// truncateOnWord shortens text to at most max characters, cutting at the last whitespace
+// that fits and marking the cut with an ellipsis. A single word longer than the limit is cut
+// mid-word.
+func truncateOnWord(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	if max < 1 {
+		return ""
+	}
+
+	cut := runes[:max-1] // leave room for the ellipsis
+	for i := max - 1; i > 0; i-- {
+		if unicode.IsSpace(runes[i]) {
+			cut = runes[:i]
+			break
+		}
+	}
+	return strings.TrimRightFunc(string(cut), unicode.IsSpace) + "…"
+}
+
+// blueSkyCall posts a JSON body to an XRPC procedure and decodes the JSON response into out.
+// Non-2xx responses are returned as a *googleapi.Error so CategorizeError can use the status code.
+func blueSkyCall(ctx context.Context, cfg BlueSkyConfig, method, token string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("invalid BlueSky request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(cfg.URL, "/")+"/"+method, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("invalid BlueSky request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("network error calling BlueSky %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return &googleapi.Error{Code: resp.StatusCode, Message: strings.TrimSpace(string(respBody)), Header: resp.Header}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("invalid BlueSky response from %s: %w", method, err)
+	}
+	return nil
+}
+
+// blueSkyError categorizes a failed BlueSky call, tags it with the video ID and logs it.
+func blueSkyError(err error, videoID, message string) *YouTubeError {
+	yErr := CategorizeError(err)
+	yErr.VideoID = videoID
+	LogYouTubeError(yErr, message)
+	return yErr
+}
@@ -0,0 +1,192 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripFunc lets a plain function serve as an http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// jsonResponse builds an HTTP response with the given status and body.
+func jsonResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+// testBlueSkyConfig returns a config whose requests are all served by transport.
+func testBlueSkyConfig(transport roundTripFunc) BlueSkyConfig {
+	return BlueSkyConfig{
+		Identifier:  "devopstoolkit.bsky.social",
+		AppPassword: "app-password",
+		URL:         "https://bsky.test/xrpc",
+		HTTPClient:  &http.Client{Transport: transport},
+	}
+}
+
+func TestPostToBlueSky_Success(t *testing.T) {
+	YouTubeMetrics.Reset()
+	useFakeClock(t)
+
+	var record blueSkyCreateRecordRequest
+	cfg := testBlueSkyConfig(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/xrpc/com.atproto.server.createSession":
+			var login map[string]string
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&login))
+			assert.Equal(t, "devopstoolkit.bsky.social", login["identifier"])
+			assert.Equal(t, "app-password", login["password"])
+			return jsonResponse(http.StatusOK, `{"accessJwt": "jwt-123", "did": "did:plc:abc"}`), nil
+		case "/xrpc/com.atproto.repo.createRecord":
+			assert.Equal(t, "Bearer jwt-123", req.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&record))
+			return jsonResponse(http.StatusOK, `{"uri": "at://did:plc:abc/app.bsky.feed.post/3k7q", "cid": "bafy"}`), nil
+		}
+		t.Fatalf("unexpected request to %s", req.URL.Path)
+		return nil, nil
+	})
+
+	video := &storage.Video{VideoId: "dQw4w9WgXcQ", Tweet: "New video is out! [YOUTUBE]"}
+	uri, err := PostToBlueSky(context.Background(), cfg, video)
+	require.NoError(t, err)
+
+	assert.Equal(t, "at://did:plc:abc/app.bsky.feed.post/3k7q", uri)
+	assert.Equal(t, "did:plc:abc", record.Repo)
+	assert.Equal(t, "app.bsky.feed.post", record.Collection)
+	assert.Equal(t, "New video is out!\n\nhttps://youtu.be/dQw4w9WgXcQ", record.Record.Text)
+	assert.Equal(t, "2025-01-01T00:00:00.000Z", record.Record.CreatedAt)
+	require.Len(t, record.Record.Facets, 1)
+	facet := record.Record.Facets[0]
+	assert.Equal(t, "https://youtu.be/dQw4w9WgXcQ", record.Record.Text[facet.Index.ByteStart:facet.Index.ByteEnd])
+	assert.Equal(t, int64(1), YouTubeMetrics.GetBlueSkyPostSuccess())
+	assert.Equal(t, int64(0), YouTubeMetrics.GetBlueSkyPostFailure())
+}
+
+func TestPostToBlueSky_Failures(t *testing.T) {
+	tests := []struct {
+		name         string
+		video        *storage.Video
+		transport    roundTripFunc
+		expectedType ErrorType
+	}{
+		{
+			name:         "missing video ID",
+			video:        &storage.Video{Tweet: "Hello"},
+			expectedType: ErrorTypeInvalid,
+		},
+		{
+			name:         "missing tweet",
+			video:        &storage.Video{VideoId: "dQw4w9WgXcQ"},
+			expectedType: ErrorTypeInvalid,
+		},
+		{
+			name:  "rejected app password",
+			video: &storage.Video{VideoId: "dQw4w9WgXcQ", Tweet: "Hello"},
+			transport: func(req *http.Request) (*http.Response, error) {
+				return jsonResponse(http.StatusUnauthorized, `{"error": "AuthenticationRequired"}`), nil
+			},
+			expectedType: ErrorTypeAuth,
+		},
+		{
+			name:  "network failure",
+			video: &storage.Video{VideoId: "dQw4w9WgXcQ", Tweet: "Hello"},
+			transport: func(req *http.Request) (*http.Response, error) {
+				return nil, errors.New("dial tcp: connection refused")
+			},
+			expectedType: ErrorTypeNetwork,
+		},
+		{
+			name:  "create record server error",
+			video: &storage.Video{VideoId: "dQw4w9WgXcQ", Tweet: "Hello"},
+			transport: func(req *http.Request) (*http.Response, error) {
+				if strings.HasSuffix(req.URL.Path, "createSession") {
+					return jsonResponse(http.StatusOK, `{"accessJwt": "jwt", "did": "did:plc:abc"}`), nil
+				}
+				return jsonResponse(http.StatusBadGateway, `upstream failure`), nil
+			},
+			expectedType: ErrorTypeServer,
+		},
+		{
+			name:  "malformed create record response",
+			video: &storage.Video{VideoId: "dQw4w9WgXcQ", Tweet: "Hello"},
+			transport: func(req *http.Request) (*http.Response, error) {
+				if strings.HasSuffix(req.URL.Path, "createSession") {
+					return jsonResponse(http.StatusOK, `{"accessJwt": "jwt", "did": "did:plc:abc"}`), nil
+				}
+				return jsonResponse(http.StatusOK, `{}`), nil
+			},
+			expectedType: ErrorTypeInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			YouTubeMetrics.Reset()
+			transport := tt.transport
+			if transport == nil {
+				transport = func(req *http.Request) (*http.Response, error) {
+					t.Fatalf("no request expected, got %s", req.URL.Path)
+					return nil, nil
+				}
+			}
+
+			_, err := PostToBlueSky(context.Background(), testBlueSkyConfig(transport), tt.video)
+			require.Error(t, err)
+
+			var yErr *YouTubeError
+			require.True(t, errors.As(err, &yErr))
+			assert.Equal(t, tt.expectedType, yErr.Type)
+			assert.Equal(t, int64(1), YouTubeMetrics.GetBlueSkyPostFailure())
+			assert.Equal(t, int64(0), YouTubeMetrics.GetBlueSkyPostSuccess())
+		})
+	}
+}
+
+func TestComposeBlueSkyText_TruncatesOnWordBoundary(t *testing.T) {
+	link := "https://youtu.be/dQw4w9WgXcQ"
+	tweet := strings.Repeat("kubernetes ", 40) + "[YOUTUBE]"
+
+	text := composeBlueSkyText(tweet, link)
+
+	assert.LessOrEqual(t, utf8.RuneCountInString(text), MaxBlueSkyPostLength)
+	assert.True(t, strings.HasSuffix(text, "kubernetes…\n\n"+link), text)
+	assert.NotContains(t, text, blueSkyLinkPlaceholder)
+}
+
+func TestTruncateOnWord(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		max      int
+		expected string
+	}{
+		{name: "fits", text: "short post", max: 20, expected: "short post"},
+		{name: "cuts at last space", text: "one two three four", max: 12, expected: "one two…"},
+		{name: "single long word", text: "abcdefghijkl", max: 5, expected: "abcd…"},
+		{name: "counts runes", text: "żółw żółw żółw", max: 10, expected: "żółw żółw…"},
+		{name: "no room", text: "anything", max: 0, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, truncateOnWord(tt.text, tt.max))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+	"google.golang.org/api/youtube/v3"
+)
+
+// UploadCaption inserts an SRT subtitle file as a caption track for the given video.
+// The language is validated up front and a missing file is rejected before any API call.
+// API failures are returned as a categorized *YouTubeError.
+func UploadCaption(ctx context.Context, service *youtube.Service, videoID, language, srtPath string) (*youtube.Caption, error) {
+	if skipForDryRun("upload %s caption %s for video ID %s", language, srtPath, videoID) {
+		return &youtube.Caption{Snippet: &youtube.CaptionSnippet{VideoId: videoID, Language: language}}, nil
+	}
+	done, err := trackOperation()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	caption, err := uploadCaption(ctx, service, videoID, language, srtPath)
+	audit(AuditOperationCaption, videoID, err)
+	return caption, err
+}
+
+func uploadCaption(ctx context.Context, service *youtube.Service, videoID, language, srtPath string) (*youtube.Caption, error) {
+	if service == nil {
+		YouTubeMetrics.IncCaptionUploadFailure()
+		return nil, fmt.Errorf("youtube service is required to upload captions")
+	}
+	if videoID == "" {
+		YouTubeMetrics.IncCaptionUploadFailure()
+		return nil, fmt.Errorf("video ID is required to upload captions")
+	}
+
+	language = constants.NormalizeLanguage(language)
+	if !constants.IsValidLanguage(language) {
+		YouTubeMetrics.IncCaptionUploadFailure()
+		return nil, NewLanguageError(language, fmt.Errorf("invalid caption language"))
+	}
+
+	file, err := os.Open(srtPath)
+	if err != nil {
+		YouTubeMetrics.IncCaptionUploadFailure()
+		return nil, fmt.Errorf("failed to open caption file %s: %w", srtPath, err)
+	}
+	defer file.Close()
+
+	caption := &youtube.Caption{
+		Snippet: &youtube.CaptionSnippet{
+			VideoId:  videoID,
+			Language: language,
+		},
+	}
+
+	response, err := service.Captions.Insert([]string{"snippet"}, caption).Media(file).Context(ctx).Do()
+	if err != nil {
+		yErr := CategorizeError(err)
+		yErr.VideoID = videoID
+		yErr.Language = language
+		LogYouTubeError(yErr, "Failed to upload caption")
+		YouTubeMetrics.IncCaptionUploadFailure()
+		return nil, yErr
+	}
+
+	YouTubeMetrics.IncCaptionUploadSuccess()
+	LogYouTubeInfo("Uploaded %s caption %s for video ID %s", language, response.Id, videoID)
+	return response, nil
+}
@@ -0,0 +1,114 @@
+package publishing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	"google.golang.org/api/youtube/v3"
+)
+
+// newTestYouTubeService returns a YouTube service that sends every request to handler.
+func newTestYouTubeService(t *testing.T, handler http.HandlerFunc) *youtube.Service {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	service, err := youtube.NewService(context.Background(),
+		option.WithEndpoint(server.URL+"/"),
+		option.WithHTTPClient(server.Client()))
+	require.NoError(t, err)
+	return service
+}
+
+// writeTestSRT creates a small subtitle file and returns its path.
+func writeTestSRT(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "captions.srt")
+	require.NoError(t, os.WriteFile(path, []byte("1\n00:00:00,000 --> 00:00:02,000\nHello\n"), 0644))
+	return path
+}
+
+func TestUploadCaption_Success(t *testing.T) {
+	YouTubeMetrics.Reset()
+	srtPath := writeTestSRT(t)
+
+	var uploaded string
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/upload/youtube/v3/captions", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		uploaded = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "caption-123", "snippet": {"videoId": "abc", "language": "es"}}`))
+	})
+
+	caption, err := UploadCaption(context.Background(), service, "abc", "ES", srtPath)
+	require.NoError(t, err)
+	assert.Equal(t, "caption-123", caption.Id)
+	assert.Contains(t, uploaded, `"language":"es"`)
+	assert.Contains(t, uploaded, "Hello")
+	assert.Equal(t, int64(1), YouTubeMetrics.GetCaptionUploadSuccess())
+	assert.Equal(t, int64(0), YouTubeMetrics.GetCaptionUploadFailure())
+}
+
+func TestUploadCaption_APIError(t *testing.T) {
+	YouTubeMetrics.Reset()
+	srtPath := writeTestSRT(t)
+
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"code": 403, "message": "forbidden"}}`))
+	})
+
+	_, err := UploadCaption(context.Background(), service, "abc", "en", srtPath)
+	require.Error(t, err)
+
+	var yErr *YouTubeError
+	require.True(t, errors.As(err, &yErr))
+	assert.Equal(t, ErrorTypeAuth, yErr.Type)
+	assert.Equal(t, "abc", yErr.VideoID)
+	assert.Equal(t, int64(1), YouTubeMetrics.GetCaptionUploadFailure())
+}
+
+func TestUploadCaption_RejectsBadInputEarly(t *testing.T) {
+	srtPath := writeTestSRT(t)
+
+	calls := 0
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	})
+
+	tests := []struct {
+		name     string
+		videoID  string
+		language string
+		path     string
+		errorIs  error
+	}{
+		{name: "Invalid language", videoID: "abc", language: "xx", path: srtPath},
+		{name: "Missing file", videoID: "abc", language: "en", path: filepath.Join(t.TempDir(), "missing.srt"), errorIs: os.ErrNotExist},
+		{name: "Missing video ID", videoID: "", language: "en", path: srtPath},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			YouTubeMetrics.Reset()
+			_, err := UploadCaption(context.Background(), service, tt.videoID, tt.language, tt.path)
+			require.Error(t, err)
+			if tt.errorIs != nil {
+				assert.ErrorIs(t, err, tt.errorIs)
+			}
+			assert.Equal(t, int64(1), YouTubeMetrics.GetCaptionUploadFailure())
+		})
+	}
+	assert.Equal(t, 0, calls, "invalid input must not reach the API")
+}
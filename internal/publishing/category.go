@@ -0,0 +1,38 @@
+package publishing
+
+import (
+	"fmt"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+	"devopstoolkit/youtube-automation/internal/storage"
+	"google.golang.org/api/youtube/v3"
+)
+
+// ApplyCategory resolves the video's category to a YouTube category ID and sets it on the
+// YouTube video object. Unknown or empty categories fall back to defaultCategoryID with a
+// warning, mirroring how invalid languages are handled.
+func ApplyCategory(youtubeVideo *youtube.Video, video *storage.Video, defaultCategoryID string) error {
+	if youtubeVideo == nil {
+		return fmt.Errorf("youtube video is required to apply a category")
+	}
+	if video == nil {
+		return fmt.Errorf("video metadata is required to apply a category")
+	}
+
+	categoryID, ok := constants.CategoryID(video.Category)
+	if !ok {
+		fallbackID, valid := constants.CategoryID(defaultCategoryID)
+		if !valid {
+			return fmt.Errorf("unknown category '%s' and invalid default category ID '%s'", video.Category, defaultCategoryID)
+		}
+		LogYouTubeWarn("Unknown category '%s', falling back to default category ID '%s'", video.Category, fallbackID)
+		YouTubeMetrics.IncCategoryFallback()
+		categoryID = fallbackID
+	}
+
+	if youtubeVideo.Snippet == nil {
+		youtubeVideo.Snippet = &youtube.VideoSnippet{}
+	}
+	youtubeVideo.Snippet.CategoryId = categoryID
+	return nil
+}
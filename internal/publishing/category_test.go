@@ -0,0 +1,42 @@
+package publishing
+
+import (
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/youtube/v3"
+)
+
+func TestApplyCategory(t *testing.T) {
+	tests := []struct {
+		name             string
+		category         string
+		expectedID       string
+		expectedFallback int64
+	}{
+		{name: "Known category", category: "Education", expectedID: "27", expectedFallback: 0},
+		{name: "Unknown category", category: "testing", expectedID: constants.DefaultCategoryID, expectedFallback: 1},
+		{name: "Empty category", category: "", expectedID: constants.DefaultCategoryID, expectedFallback: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			YouTubeMetrics.Reset()
+			youtubeVideo := &youtube.Video{}
+
+			err := ApplyCategory(youtubeVideo, &storage.Video{Category: tt.category}, constants.DefaultCategoryID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedID, youtubeVideo.Snippet.CategoryId)
+			assert.Equal(t, tt.expectedFallback, YouTubeMetrics.GetCategoryFallback())
+		})
+	}
+}
+
+func TestApplyCategory_Errors(t *testing.T) {
+	assert.Error(t, ApplyCategory(nil, &storage.Video{}, constants.DefaultCategoryID))
+	assert.Error(t, ApplyCategory(&youtube.Video{}, nil, constants.DefaultCategoryID))
+	assert.Error(t, ApplyCategory(&youtube.Video{}, &storage.Video{Category: "testing"}, "not-a-category"))
+}
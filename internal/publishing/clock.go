@@ -0,0 +1,52 @@
+package publishing
+
+import (
+	"context"
+	"time"
+)
+
+// Clock abstracts wall-clock time so retry timing and date handling can be tested without real sleeps.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+// RealClock is the Clock backed by the time package.
+type RealClock struct{}
+
+// Now returns the current local time.
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep pauses the current goroutine for at least d.
+func (RealClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// sleepContext waits like Sleep but returns early with ctx's error when ctx is done.
+func (RealClock) sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// clock is the Clock used by the publishing package, replaceable for testing
+var clock Clock = RealClock{}
+
+// sleepContext waits for d on the given clock, honouring ctx cancellation where the clock
+// supports it. Clocks that only implement Sleep are checked for cancellation afterwards.
+func sleepContext(ctx context.Context, c Clock, d time.Duration) error {
+	if sleeper, ok := c.(interface {
+		sleepContext(ctx context.Context, d time.Duration) error
+	}); ok {
+		return sleeper.sleepContext(ctx, d)
+	}
+	c.Sleep(d)
+	return ctx.Err()
+}
@@ -0,0 +1,96 @@
+package publishing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a Clock whose Sleep advances time instantly and records each requested duration.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+}
+
+// useFakeClock installs a fake clock for the duration of a test.
+func useFakeClock(t *testing.T) *fakeClock {
+	t.Helper()
+	fake := &fakeClock{now: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	original := clock
+	clock = fake
+	t.Cleanup(func() {
+		clock = original
+	})
+	return fake
+}
+
+// disableJitter makes backoff delays deterministic for the duration of a test.
+func disableJitter(t *testing.T) {
+	t.Helper()
+	original := retryJitter
+	retryJitter = func(d time.Duration) time.Duration { return d }
+	t.Cleanup(func() {
+		retryJitter = original
+	})
+}
+
+func TestRetryWithBackoff_FakeClockSchedule(t *testing.T) {
+	fake := useFakeClock(t)
+	disableJitter(t)
+	setBackoff(t, time.Second, 30*time.Second)
+
+	start := time.Now()
+	err := RetryWithBackoff(context.Background(), func() error {
+		return errors.New("network timeout")
+	}, retryAttempts(4))
+
+	require.Error(t, err)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}, fake.sleeps)
+	assert.Equal(t, 7*time.Second, fake.Now().Sub(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Less(t, time.Since(start), time.Second, "the fake clock must not really sleep")
+}
+
+func TestRetryWithBackoff_FakeClockCancelled(t *testing.T) {
+	fake := useFakeClock(t)
+	disableJitter(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := RetryWithBackoff(ctx, func() error {
+		calls++
+		cancel()
+		return errors.New("network timeout")
+	}, retryAttempts(5))
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+	assert.Len(t, fake.sleeps, 1)
+}
+
+func TestParseRetryAfterValue_UsesClock(t *testing.T) {
+	fake := useFakeClock(t)
+
+	date := fake.Now().Add(90 * time.Second).Format(http.TimeFormat)
+	assert.Equal(t, 90*time.Second, parseRetryAfterValue(date))
+}
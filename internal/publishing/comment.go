@@ -0,0 +1,75 @@
+package publishing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+// PinComment posts text as a top-level comment on the video, the automated part of the
+// YouTube pinned comment step. The YouTube Data API can't pin comments, so pinning itself is
+// left to YouTube Studio and logged as such. When the channel isn't allowed to comment on the
+// video, such as when comments are disabled, a warning is logged and nil is returned so the
+// rest of the workflow goes on. Other API failures are returned as a categorized *YouTubeError.
+func PinComment(ctx context.Context, service *youtube.Service, videoID, text string) error {
+	if skipForDryRun("post a pinned comment on video ID %s", videoID) {
+		return nil
+	}
+	done, err := trackOperation()
+	if err != nil {
+		return err
+	}
+	defer done()
+	err = pinComment(ctx, service, videoID, text)
+	audit(AuditOperationComment, videoID, err)
+	if isCommentPermissionError(err) {
+		LogYouTubeWarn("Skipping the pinned comment on video ID %s, the channel lacks permission to comment: %v", videoID, err)
+		return nil
+	}
+	return err
+}
+
+func pinComment(ctx context.Context, service *youtube.Service, videoID, text string) error {
+	if service == nil {
+		return fmt.Errorf("youtube service is required to post a comment")
+	}
+	if videoID == "" {
+		return fmt.Errorf("video ID is required to post a comment")
+	}
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("comment text is required to post a comment")
+	}
+
+	thread := &youtube.CommentThread{
+		Snippet: &youtube.CommentThreadSnippet{
+			VideoId: videoID,
+			TopLevelComment: &youtube.Comment{
+				Snippet: &youtube.CommentSnippet{TextOriginal: text},
+			},
+		},
+	}
+	response, err := service.CommentThreads.Insert([]string{"snippet"}, thread).Context(ctx).Do()
+	if err != nil {
+		yErr := CategorizeError(err)
+		yErr.VideoID = videoID
+		LogYouTubeError(yErr, "Failed to post comment")
+		return yErr
+	}
+
+	LogYouTubeInfo("Comment %s posted on video ID %s; the API can't pin it, pin it in YouTube Studio", response.Id, videoID)
+	return nil
+}
+
+// isCommentPermissionError reports whether err is the 403 the API returns when the channel
+// may not comment on a video. Quota errors, also 403s, are categorized as rate limits instead.
+func isCommentPermissionError(err error) bool {
+	var yErr *YouTubeError
+	var apiErr *googleapi.Error
+	return errors.As(err, &yErr) && yErr.Type == ErrorTypeAuth &&
+		errors.As(err, &apiErr) && apiErr.Code == http.StatusForbidden
+}
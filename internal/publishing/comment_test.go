@@ -0,0 +1,98 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/youtube/v3"
+)
+
+func TestPinComment_Success(t *testing.T) {
+	var thread youtube.CommentThread
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/youtube/v3/commentThreads", r.URL.Path)
+		assert.Equal(t, "snippet", r.URL.Query().Get("part"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&thread))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "comment-123"}`))
+	})
+	buf := captureLogs(t)
+
+	require.NoError(t, PinComment(context.Background(), service, "dQw4w9WgXcQ", "Which tool do you use?"))
+	assert.Equal(t, "dQw4w9WgXcQ", thread.Snippet.VideoId)
+	assert.Equal(t, "Which tool do you use?", thread.Snippet.TopLevelComment.Snippet.TextOriginal)
+	assert.Contains(t, buf.String(), "pin it in YouTube Studio")
+}
+
+func TestPinComment_PermissionErrorIsSkipped(t *testing.T) {
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"code": 403, "message": "comments disabled", "errors": [{"reason": "commentsDisabled"}]}}`))
+	})
+	buf := captureLogs(t)
+	path := useAuditLog(t)
+
+	assert.NoError(t, PinComment(context.Background(), service, "dQw4w9WgXcQ", "Which tool do you use?"))
+	assert.Contains(t, buf.String(), "Skipping the pinned comment on video ID dQw4w9WgXcQ")
+
+	records := readAuditRecords(t, path)
+	require.Len(t, records, 1)
+	assert.Equal(t, AuditOperationComment, records[0].Operation)
+	assert.False(t, records[0].Success, "the skipped comment is still audited as failed")
+}
+
+func TestPinComment_OtherErrorsFail(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		expectedType ErrorType
+	}{
+		{
+			name:         "Quota",
+			status:       http.StatusForbidden,
+			body:         `{"error": {"code": 403, "message": "quota", "errors": [{"reason": "quotaExceeded"}]}}`,
+			expectedType: ErrorTypeRateLimit,
+		},
+		{
+			name:         "Expired token",
+			status:       http.StatusUnauthorized,
+			body:         `{"error": {"code": 401, "message": "invalid credentials"}}`,
+			expectedType: ErrorTypeAuth,
+		},
+		{
+			name:         "Server error",
+			status:       http.StatusInternalServerError,
+			body:         `{"error": {"code": 500, "message": "backend error"}}`,
+			expectedType: ErrorTypeServer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+
+			err := PinComment(context.Background(), service, "dQw4w9WgXcQ", "Which tool do you use?")
+
+			var yErr *YouTubeError
+			require.ErrorAs(t, err, &yErr)
+			assert.Equal(t, tt.expectedType, yErr.Type)
+			assert.Equal(t, "dQw4w9WgXcQ", yErr.VideoID)
+		})
+	}
+}
+
+func TestPinComment_InvalidInput(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+
+	assert.Error(t, PinComment(context.Background(), nil, "dQw4w9WgXcQ", "text"))
+	assert.Error(t, PinComment(context.Background(), service, "", "text"))
+	assert.Error(t, PinComment(context.Background(), service, "dQw4w9WgXcQ", "  "))
+}
@@ -0,0 +1,106 @@
+package publishing
+
+import (
+	"errors"
+	"fmt"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+)
+
+// PublishConfig gathers the settings that control publishing, which otherwise come from
+// package-level defaults, so an upload can be configured and validated in one place.
+type PublishConfig struct {
+	DefaultLanguage   string      // Language used when a video's own is unset or invalid, GetDefaultLanguage when empty
+	DefaultCategoryID string      // Category used when a video's own is unknown, constants.DefaultCategoryID when empty
+	Retry             RetryPolicy // Retries of resumable uploads, whose zero fields take the defaults
+	DryRun            bool        // Log the actions instead of performing them, like the DryRun variable
+	// DefaultMadeForKids is the audience declared for videos that leave MadeForKids unset
+	DefaultMadeForKids bool
+	// CaptionLanguages are the languages of the caption tracks uploaded with the videos, checked
+	// with CheckLanguageConsistency before each upload
+	CaptionLanguages []string
+	// Uploader sends the videos resumably, with Retry applied to it; videos are uploaded through
+	// the service in a single request when nil
+	Uploader *ResumableUploader
+}
+
+// DefaultPublishConfig returns the configuration matching the package-level defaults.
+func DefaultPublishConfig() PublishConfig {
+	return PublishConfig{
+		DefaultLanguage:   GetDefaultLanguage(),
+		DefaultCategoryID: constants.DefaultCategoryID,
+		Retry:             DefaultRetryPolicy(),
+		DryRun:            DryRun,
+	}
+}
+
+// Validate checks that the default language is in constants.LanguageMap, the default
+// category is in constants.CategoryMap and the retry delays aren't negative. Empty defaults
+// are valid since they mean the package-level ones. All problems are reported together.
+func (c PublishConfig) Validate() error {
+	var errs []error
+	if c.DefaultLanguage != "" && !constants.IsValidLanguage(c.DefaultLanguage) {
+		errs = append(errs, fmt.Errorf("invalid default language code '%s'", c.DefaultLanguage))
+	}
+	if c.DefaultCategoryID != "" {
+		if _, ok := constants.CategoryID(c.DefaultCategoryID); !ok {
+			errs = append(errs, fmt.Errorf("unknown default category '%s'", c.DefaultCategoryID))
+		}
+	}
+	if c.Retry.BaseDelay < 0 || c.Retry.MaxDelay < 0 {
+		errs = append(errs, fmt.Errorf("retry delays must not be negative"))
+	}
+	return errors.Join(errs...)
+}
+
+// languageOrDefault returns the configured default language, or the package-level one.
+func (c *PublishConfig) languageOrDefault(fallback string) string {
+	if c == nil || c.DefaultLanguage == "" {
+		return fallback
+	}
+	return constants.NormalizeLanguage(c.DefaultLanguage)
+}
+
+// categoryOrDefault returns the configured default category ID, or constants.DefaultCategoryID.
+func (c *PublishConfig) categoryOrDefault() string {
+	if c == nil || c.DefaultCategoryID == "" {
+		return constants.DefaultCategoryID
+	}
+	id, _ := constants.CategoryID(c.DefaultCategoryID)
+	return id
+}
+
+// retryPolicy returns the configured retry policy, zero when c is nil so the uploader's defaults
+// apply.
+func (c *PublishConfig) retryPolicy() RetryPolicy {
+	if c == nil {
+		return RetryPolicy{}
+	}
+	return c.Retry
+}
+
+// uploader returns the configured resumable uploader, none when c is nil.
+func (c *PublishConfig) uploader() *ResumableUploader {
+	if c == nil {
+		return nil
+	}
+	return c.Uploader
+}
+
+// captionLanguages returns the configured caption languages, none when c is nil.
+func (c *PublishConfig) captionLanguages() []string {
+	if c == nil {
+		return nil
+	}
+	return c.CaptionLanguages
+}
+
+// dryRun reports whether actions are only logged, either through the config or DryRun.
+func (c *PublishConfig) dryRun() bool {
+	return DryRun || (c != nil && c.DryRun)
+}
+
+// madeForKidsOrDefault returns the configured default audience, not made for kids when unset.
+func (c *PublishConfig) madeForKidsOrDefault() bool {
+	return c != nil && c.DefaultMadeForKids
+}
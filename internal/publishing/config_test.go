@@ -0,0 +1,146 @@
+package publishing
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/youtube/v3"
+)
+
+func TestPublishConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        PublishConfig
+		expectedError string
+	}{
+		{name: "Defaults", config: DefaultPublishConfig()},
+		{name: "Empty", config: PublishConfig{}},
+		{name: "Valid", config: PublishConfig{DefaultLanguage: "pt-BR", DefaultCategoryID: "27", Retry: retryAttempts(2), DryRun: true}},
+		{name: "Category name", config: PublishConfig{DefaultCategoryID: "Science & Technology"}},
+		{name: "Unknown default language", config: PublishConfig{DefaultLanguage: "klingon"}, expectedError: "invalid default language code 'klingon'"},
+		{name: "Unknown default category", config: PublishConfig{DefaultCategoryID: "999"}, expectedError: "unknown default category '999'"},
+		{name: "Negative delay", config: PublishConfig{Retry: RetryPolicy{BaseDelay: -time.Second}}, expectedError: "must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestUploadVideo_Config(t *testing.T) {
+	var metadata youtube.Video
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		metadata = decodeUploadMetadata(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "new-video-id"}`))
+	})
+	video := &storage.Video{Title: "Title", Category: "unknown", Language: "klingon", UploadVideo: writeTestVideoFile(t, 100)}
+	config := &PublishConfig{DefaultLanguage: "es", DefaultCategoryID: "Education", DefaultMadeForKids: true}
+
+	_, err := UploadVideo(context.Background(), service, video, PublishOptions{Config: config})
+	require.NoError(t, err)
+	assert.Equal(t, "es", metadata.Snippet.DefaultLanguage)
+	assert.Equal(t, "27", metadata.Snippet.CategoryId)
+	assert.True(t, metadata.Status.SelfDeclaredMadeForKids)
+}
+
+func TestUploadVideo_InvalidConfig(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+	video := &storage.Video{Title: "Title", UploadVideo: writeTestVideoFile(t, 100)}
+
+	_, err := UploadVideo(context.Background(), service, video, PublishOptions{Config: &PublishConfig{DefaultLanguage: "klingon"}})
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+	assert.ErrorContains(t, err, "invalid default language code 'klingon'")
+}
+
+func TestUploadVideo_ConfigDryRun(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+	video := &storage.Video{Title: "Title", VideoId: "existing-id", UploadVideo: writeTestVideoFile(t, 100)}
+
+	videoID, err := UploadVideo(context.Background(), service, video, PublishOptions{Config: &PublishConfig{DryRun: true}})
+	require.NoError(t, err)
+	assert.Equal(t, DryRunVideoID, videoID)
+	assert.Equal(t, "existing-id", video.VideoId, "a dry run must not touch the video ID")
+}
+
+func TestUploadVideo_ConfigRetryPolicy(t *testing.T) {
+	server := newFakeUploadServer(t, 200)
+	video := &storage.Video{Title: "Title", UploadVideo: writeTestVideoFile(t, 250)}
+	uploader := &ResumableUploader{Client: server.Client(), BasePath: server.URL + "/", ChunkSize: 100}
+
+	_, err := UploadVideo(context.Background(), nil, video, PublishOptions{Config: &PublishConfig{Uploader: uploader, Retry: retryAttempts(1)}})
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeServer, yErr.Type, "a single attempt must not retry the interrupted chunk")
+	assert.Equal(t, []int64{0, 100, 200}, server.chunkStarts)
+}
+
+func TestUploadVideo_PartialConfigRetryBacksOff(t *testing.T) {
+	fake := useFakeClock(t)
+	disableJitter(t)
+	setBackoff(t, time.Second, 30*time.Second)
+	server := newFakeUploadServer(t, 200)
+	video := &storage.Video{Title: "Title", UploadVideo: writeTestVideoFile(t, 250)}
+	uploader := &ResumableUploader{Client: server.Client(), BasePath: server.URL + "/", ChunkSize: 100}
+
+	_, err := UploadVideo(context.Background(), nil, video, PublishOptions{Config: &PublishConfig{Uploader: uploader, Retry: RetryPolicy{MaxAttempts: 2}}})
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{time.Second}, fake.sleeps, "unset delays must take the defaults instead of retrying at once")
+}
+
+func TestUploadBatchAndReader_ApplyConfig(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+	config := &PublishConfig{DryRun: true}
+
+	result := UploadBatch(context.Background(), service, []*storage.Video{{Title: "Title", UploadVideo: writeTestVideoFile(t, 100)}}, 1, config)
+	require.Equal(t, 1, result.Succeeded)
+	assert.Equal(t, DryRunVideoID, result.Outcomes[0].VideoID)
+
+	videoID, err := UploadVideoReader(context.Background(), service, strings.NewReader("stream"), 6, &storage.Video{Title: "Title"}, config)
+	require.NoError(t, err)
+	assert.Equal(t, DryRunVideoID, videoID)
+
+	_, err = UploadVideoReader(context.Background(), service, strings.NewReader("stream"), 6, &storage.Video{Title: "Title"}, &PublishConfig{DefaultLanguage: "klingon"})
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+}
+
+func TestUploads_CheckCaptionLanguages(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+	config := &PublishConfig{DryRun: true, CaptionLanguages: []string{"en"}}
+	newVideo := func() *storage.Video {
+		return &storage.Video{Title: "Title", AudioLanguage: "de", UploadVideo: writeTestVideoFile(t, 100)}
+	}
+
+	logs := captureLogs(t)
+	_, err := UploadVideo(context.Background(), service, newVideo(), PublishOptions{Config: config})
+	require.NoError(t, err)
+	assert.Contains(t, logs.String(), "no caption track matches the audio language 'de'")
+
+	logs = captureLogs(t)
+	UploadBatch(context.Background(), service, []*storage.Video{newVideo()}, 1, config)
+	assert.Contains(t, logs.String(), "no caption track matches the audio language 'de'")
+
+	logs = captureLogs(t)
+	_, err = UploadVideoReader(context.Background(), service, strings.NewReader("stream"), 6, newVideo(), config)
+	require.NoError(t, err)
+	assert.Contains(t, logs.String(), "no caption track matches the audio language 'de'")
+}
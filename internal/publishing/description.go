@@ -0,0 +1,102 @@
+package publishing
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+)
+
+// MaxDescriptionLength is YouTube's limit on the number of characters in a video description.
+const MaxDescriptionLength = 5000
+
+// BuildDescription returns the video description followed by its description tags formatted
+// as hashtags. If the result would exceed MaxDescriptionLength, trailing hashtags are dropped
+// so the text is cut on a word boundary. It returns an error if the description alone is too long.
+func BuildDescription(video *storage.Video) (string, error) {
+	if video == nil {
+		return "", fmt.Errorf("video metadata is required to build a description")
+	}
+	return buildDescription(strings.TrimSpace(video.Description), video.DescriptionTags, MaxDescriptionLength)
+}
+
+// BuildDescriptionWithChapters works like BuildDescription but also lists the video's timecodes
+// as "mm:ss Title" lines between the description and the hashtags, which is what makes YouTube
+// generate chapters. It returns the ParseTimecodes error when the timecodes are invalid, so the
+// caller can decide whether to fall back to BuildDescription.
+func BuildDescriptionWithChapters(video *storage.Video) (string, error) {
+	return buildDescriptionWithChapters(video, MaxDescriptionLength)
+}
+
+// buildDescriptionWithChapters implements BuildDescriptionWithChapters, keeping the result
+// within limit characters.
+func buildDescriptionWithChapters(video *storage.Video, limit int) (string, error) {
+	if video == nil {
+		return "", fmt.Errorf("video metadata is required to build a description")
+	}
+	chapters, err := ParseTimecodes(video.Timecodes)
+	if err != nil {
+		return "", fmt.Errorf("invalid chapters: %w", err)
+	}
+
+	lines := make([]string, len(chapters))
+	for i, chapter := range chapters {
+		lines[i] = FormatTimecode(chapter.Start) + " " + chapter.Title
+	}
+	description := strings.TrimSpace(video.Description)
+	if description != "" {
+		description += "\n\n"
+	}
+	return buildDescription(description+strings.Join(lines, "\n"), video.DescriptionTags, limit)
+}
+
+// buildDescription appends the description tags as hashtags to body, dropping trailing tags that
+// would push it past limit characters. It returns an error if body alone is too long.
+func buildDescription(body, descriptionTags string, limit int) (string, error) {
+	if length := utf8.RuneCountInString(body); length > limit {
+		return "", fmt.Errorf("description is %d characters, exceeding the %d character limit", length, limit)
+	}
+
+	hashtags := FormatHashtags(descriptionTags)
+	if len(hashtags) == 0 {
+		return body, nil
+	}
+
+	separator := "\n\n"
+	if body == "" {
+		separator = ""
+	}
+	result := body
+	for i, tag := range hashtags {
+		next := " " + tag
+		if i == 0 {
+			next = separator + tag
+		}
+		if utf8.RuneCountInString(result)+utf8.RuneCountInString(next) > limit {
+			LogYouTubeWarn("Description limit reached, dropped %d of %d description tag(s)", len(hashtags)-i, len(hashtags))
+			break
+		}
+		result += next
+	}
+	return result, nil
+}
+
+// FormatHashtags splits description tags on whitespace and commas and returns them as
+// unique hashtags, adding the leading "#" where it's missing.
+func FormatHashtags(tags string) []string {
+	var hashtags []string
+	seen := make(map[string]bool)
+	for _, tag := range strings.FieldsFunc(tags, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	}) {
+		tag = strings.TrimLeft(tag, "#")
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+		seen[strings.ToLower(tag)] = true
+		hashtags = append(hashtags, "#"+tag)
+	}
+	return hashtags
+}
@@ -0,0 +1,122 @@
+package publishing
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatHashtags(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     string
+		expected []string
+	}{
+		{name: "Already hashtags", tags: "#golang #devops #tutorial", expected: []string{"#golang", "#devops", "#tutorial"}},
+		{name: "Missing hash", tags: "golang, devops", expected: []string{"#golang", "#devops"}},
+		{name: "Duplicates and extra hashes", tags: "#golang ##GoLang  #k8s", expected: []string{"#golang", "#k8s"}},
+		{name: "Empty", tags: "  ", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatHashtags(tt.tags))
+		})
+	}
+}
+
+func TestBuildDescription(t *testing.T) {
+	tests := []struct {
+		name        string
+		video       *storage.Video
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "Description with tags",
+			video:    &storage.Video{Description: "Learn Go.\n", DescriptionTags: "golang #devops"},
+			expected: "Learn Go.\n\n#golang #devops",
+		},
+		{
+			name:     "No tags",
+			video:    &storage.Video{Description: "Learn Go."},
+			expected: "Learn Go.",
+		},
+		{
+			name:     "Only tags",
+			video:    &storage.Video{DescriptionTags: "#golang"},
+			expected: "#golang",
+		},
+		{
+			name:     "Tags dropped at the limit",
+			video:    &storage.Video{Description: strings.Repeat("a", MaxDescriptionLength-12), DescriptionTags: "#golang #devops"},
+			expected: strings.Repeat("a", MaxDescriptionLength-12) + "\n\n#golang",
+		},
+		{
+			name:     "Description exactly at the limit",
+			video:    &storage.Video{Description: strings.Repeat("é", MaxDescriptionLength), DescriptionTags: "#golang"},
+			expected: strings.Repeat("é", MaxDescriptionLength),
+		},
+		{
+			name:        "Description over the limit",
+			video:       &storage.Video{Description: strings.Repeat("a", MaxDescriptionLength+1)},
+			expectError: true,
+		},
+		{
+			name:        "Nil video",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			description, err := BuildDescription(tt.video)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, description)
+			assert.LessOrEqual(t, utf8.RuneCountInString(description), MaxDescriptionLength)
+		})
+	}
+}
+
+func TestBuildDescriptionWithChapters(t *testing.T) {
+	video := &storage.Video{
+		Description:     "Learn how to deploy apps.",
+		DescriptionTags: "#kubernetes #devops",
+		Timecodes:       "00:00 Intro\n01:30 - Setup\n1:05:00 Wrap-up",
+	}
+
+	description, err := BuildDescriptionWithChapters(video)
+	require.NoError(t, err)
+	assert.Equal(t, "Learn how to deploy apps.\n\n00:00 Intro\n01:30 Setup\n1:05:00 Wrap-up\n\n#kubernetes #devops", description)
+}
+
+func TestBuildDescriptionWithChapters_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		video *storage.Video
+	}{
+		{name: "Invalid chapters", video: &storage.Video{Description: "Text", Timecodes: "00:10 Intro\n01:00 Middle\n02:00 End"}},
+		{name: "Placeholder chapters", video: &storage.Video{Description: "Text", Timecodes: "00:00 FIXME:\nFIXME:FIXME Section"}},
+		{name: "No chapters", video: &storage.Video{Description: "Text"}},
+		{name: "Chapters push description over the limit", video: &storage.Video{
+			Description: strings.Repeat("a", MaxDescriptionLength-10),
+			Timecodes:   "00:00 Intro\n01:00 Middle\n02:00 End",
+		}},
+		{name: "Nil video"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildDescriptionWithChapters(tt.video)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package publishing
+
+// DryRun makes the upload, caption, thumbnail and social-post functions log the action they
+// would perform and report success without calling any remote API. Metrics are left untouched
+// and posted flags are neither checked against nor written to disk.
+var DryRun bool
+
+// DryRunVideoID is the video ID the upload functions return in dry-run mode, since no video is
+// uploaded. It is not a valid YouTube video ID, so callers can tell it apart and must not save it.
+const DryRunVideoID = "dry-run"
+
+// skipForDryRun logs the planned action at Info level and reports whether it should be skipped.
+func skipForDryRun(action string, args ...interface{}) bool {
+	return skipIfDryRun(DryRun, action, args...)
+}
+
+// skipIfDryRun works like skipForDryRun for callers that decide themselves whether dry-run
+// mode is on, such as through PublishConfig.DryRun.
+func skipIfDryRun(dryRun bool, action string, args ...interface{}) bool {
+	if !dryRun {
+		return false
+	}
+	baseEntry().WithField("dry_run", true).Infof("Would "+action, args...)
+	return true
+}
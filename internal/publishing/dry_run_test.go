@@ -0,0 +1,115 @@
+package publishing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// enableDryRun turns on dry-run mode for the duration of a test.
+func enableDryRun(t *testing.T) {
+	t.Helper()
+	original := DryRun
+	DryRun = true
+	t.Cleanup(func() {
+		DryRun = original
+	})
+}
+
+// failOnRequest is an HTTP handler that fails the test if any request reaches it.
+func failOnRequest(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("no request expected in dry-run mode, got %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func TestDryRun_SkipsNetworkCallsAndMetrics(t *testing.T) {
+	enableDryRun(t)
+	YouTubeMetrics.Reset()
+	buf := captureLogs(t)
+
+	server := httptest.NewServer(failOnRequest(t))
+	defer server.Close()
+	service := newTestYouTubeService(t, failOnRequest(t))
+	video := &storage.Video{Name: "intro", VideoId: "dQw4w9WgXcQ", Title: "Title", Tweet: "New video [YOUTUBE]"}
+
+	caption, err := UploadCaption(context.Background(), service, "dQw4w9WgXcQ", "en", "missing.srt")
+	require.NoError(t, err)
+	assert.Equal(t, "en", caption.Snippet.Language)
+
+	require.NoError(t, SetThumbnail(context.Background(), service, "dQw4w9WgXcQ", "missing.png"))
+	require.NoError(t, PostToSlack(context.Background(), server.URL, video))
+
+	uri, err := PostToBlueSky(context.Background(), testBlueSkyConfig(func(req *http.Request) (*http.Response, error) {
+		t.Errorf("no request expected in dry-run mode, got %s", req.URL.Path)
+		return nil, nil
+	}), video)
+	require.NoError(t, err)
+	assert.Empty(t, uri)
+
+	snapshot := YouTubeMetrics.Snapshot()
+	assert.Zero(t, snapshot.CaptionUploadSuccess+snapshot.CaptionUploadFailure)
+	assert.Zero(t, snapshot.ThumbnailSetSuccess+snapshot.ThumbnailSetFailure)
+	assert.Zero(t, snapshot.BlueSkyPostSuccess+snapshot.BlueSkyPostFailure)
+
+	entries := decodeLogLines(t, buf)
+	require.Len(t, entries, 4)
+	for _, entry := range entries {
+		assert.Equal(t, "info", entry["level"])
+		assert.Equal(t, true, entry["dry_run"])
+	}
+	assert.Equal(t, "Would upload en caption missing.srt for video ID dQw4w9WgXcQ", entries[0]["msg"])
+	assert.Equal(t, "Would set thumbnail missing.png for video ID dQw4w9WgXcQ", entries[1]["msg"])
+	assert.Contains(t, entries[2]["msg"], "Would post video ID dQw4w9WgXcQ to Slack")
+	assert.Contains(t, entries[3]["msg"], "Would post video ID dQw4w9WgXcQ to BlueSky")
+}
+
+func TestDryRun_UploadsReturnTheDryRunVideoID(t *testing.T) {
+	enableDryRun(t)
+	YouTubeMetrics.Reset()
+	service := newTestYouTubeService(t, failOnRequest(t))
+	video := &storage.Video{Title: "Title", UploadVideo: writeTestVideoFile(t, 100)}
+
+	videoID, err := UploadVideo(context.Background(), service, video, PublishOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, DryRunVideoID, videoID)
+
+	videoID, err = UploadVideoReader(context.Background(), service, strings.NewReader("stream"), 6, video, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DryRunVideoID, videoID)
+
+	assert.False(t, storage.IsValidVideoID(DryRunVideoID), "the dry-run ID must never be mistaken for an uploaded video")
+	assert.Empty(t, video.VideoId)
+	assert.Zero(t, YouTubeMetrics.GetUploadTotal())
+}
+
+func TestDryRun_DoesNotPersistPostedFlags(t *testing.T) {
+	enableDryRun(t)
+	logs := captureLogs(t)
+
+	server := httptest.NewServer(failOnRequest(t))
+	defer server.Close()
+	store := storage.NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	video := &storage.Video{VideoId: "dQw4w9WgXcQ", Title: "Title", Sponsorship: storage.Sponsorship{Emails: "a@example.com"}}
+
+	require.NoError(t, PostToSlackOnce(context.Background(), store, path, server.URL, video))
+	mailer := &countingMailer{}
+	sent, err := NotifySponsorsOnce(context.Background(), store, path, mailer, video)
+	require.NoError(t, err)
+
+	assert.Empty(t, sent)
+	assert.Zero(t, mailer.calls)
+	assert.False(t, video.SlackPosted)
+	assert.False(t, video.NotifiedSponsors)
+	assert.NoFileExists(t, path)
+	assert.Contains(t, logs.String(), "a***@example.com")
+	assert.NotContains(t, logs.String(), "a@example.com", "sponsor emails must be redacted in logs")
+}
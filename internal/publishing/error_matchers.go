@@ -0,0 +1,46 @@
+package publishing
+
+import "sync"
+
+var (
+	errorMatchersMu sync.RWMutex
+	errorMatchers   []func(error) (*YouTubeError, bool)
+)
+
+// RegisterErrorMatcher adds a matcher that CategorizeError consults before its built-in
+// categorization, for deployment-specific errors such as the messages of a proxy. Matchers run
+// in the order they were registered and the first one that recognizes the error wins. When the
+// returned error has no OriginalError, it is set to the categorized error.
+func RegisterErrorMatcher(match func(error) (*YouTubeError, bool)) {
+	if match == nil {
+		return
+	}
+	errorMatchersMu.Lock()
+	defer errorMatchersMu.Unlock()
+	errorMatchers = append(errorMatchers, match)
+}
+
+// ResetErrorMatchers removes every matcher added with RegisterErrorMatcher.
+func ResetErrorMatchers() {
+	errorMatchersMu.Lock()
+	defer errorMatchersMu.Unlock()
+	errorMatchers = nil
+}
+
+// matchCustomError runs the registered matchers on err and returns the result of the first one
+// that recognizes it.
+func matchCustomError(err error) (*YouTubeError, bool) {
+	errorMatchersMu.RLock()
+	matchers := errorMatchers
+	errorMatchersMu.RUnlock()
+
+	for _, match := range matchers {
+		if yErr, ok := match(err); ok && yErr != nil {
+			if yErr.OriginalError == nil {
+				yErr.OriginalError = err
+			}
+			return yErr, true
+		}
+	}
+	return nil, false
+}
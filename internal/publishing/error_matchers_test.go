@@ -0,0 +1,65 @@
+package publishing
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterErrorMatcher(t *testing.T) {
+	t.Cleanup(ResetErrorMatchers)
+	proxyErr := errors.New("proxy said: upstream budget depleted")
+	assert.Equal(t, ErrorTypeUnknown, CategorizeError(proxyErr).Type, "unknown without a matcher")
+
+	RegisterErrorMatcher(func(err error) (*YouTubeError, bool) {
+		if !strings.Contains(err.Error(), "budget depleted") {
+			return nil, false
+		}
+		return &YouTubeError{Type: ErrorTypeRateLimit, Message: "Proxy budget depleted", Retryable: true}, true
+	})
+
+	yErr := CategorizeError(proxyErr)
+	assert.Equal(t, ErrorTypeRateLimit, yErr.Type)
+	assert.True(t, yErr.Retryable)
+	assert.ErrorIs(t, yErr, proxyErr, "the original error is kept")
+
+	assert.Equal(t, ErrorTypeNetwork, CategorizeError(errors.New("connection refused")).Type, "unmatched errors use the built-in rules")
+}
+
+func TestRegisterErrorMatcher_TakesPrecedenceOverBuiltInRules(t *testing.T) {
+	t.Cleanup(ResetErrorMatchers)
+	RegisterErrorMatcher(func(err error) (*YouTubeError, bool) { return nil, false })
+	RegisterErrorMatcher(func(err error) (*YouTubeError, bool) {
+		return &YouTubeError{Type: ErrorTypeServer, Message: "first"}, strings.Contains(err.Error(), "video")
+	})
+	RegisterErrorMatcher(func(err error) (*YouTubeError, bool) {
+		return &YouTubeError{Type: ErrorTypeAuth, Message: "second"}, true
+	})
+
+	assert.Equal(t, "first", CategorizeError(errors.New("video processing failed")).Message, "the first matching matcher wins")
+	assert.Equal(t, "second", CategorizeError(errors.New("network down")).Message)
+
+	ResetErrorMatchers()
+	assert.Equal(t, ErrorTypeNetwork, CategorizeError(errors.New("network down")).Type)
+}
+
+func TestRegisterErrorMatcher_ConcurrentAccess(t *testing.T) {
+	t.Cleanup(ResetErrorMatchers)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterErrorMatcher(func(err error) (*YouTubeError, bool) { return nil, false })
+		}()
+		go func() {
+			defer wg.Done()
+			require.NotNil(t, CategorizeError(errors.New("boom")))
+		}()
+	}
+	wg.Wait()
+}
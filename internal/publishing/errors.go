@@ -1,8 +1,15 @@
 package publishing
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+
+	"google.golang.org/api/googleapi"
 )
 
 // ErrorType defines the category of a YouTube-related error.
@@ -24,12 +31,14 @@ const (
 
 // YouTubeError is a custom error structure to wrap and categorize errors from YouTube operations.
 type YouTubeError struct {
-	Type          ErrorType // Category of the error
-	Message       string    // Human-readable error message
-	Retryable     bool      // Indicates if the operation that caused this error can be retried
-	OriginalError error     // The original error object, if any
-	VideoID       string    // Video ID if applicable
-	Language      string    // Language code if applicable
+	Type          ErrorType     // Category of the error
+	Message       string        // Human-readable error message
+	Retryable     bool          // Indicates if the operation that caused this error can be retried
+	OriginalError error         // The original error object, if any
+	VideoID       string        // Video ID if applicable
+	Language      string        // Language code if applicable
+	RetryAfter    time.Duration // Server-provided wait before retrying, zero when no hint was given
+	Reason        string        // Reason reported by the YouTube API, such as "quotaExceeded" or "uploadLimitExceeded"
 }
 
 // Error implements the error interface for YouTubeError.
@@ -46,13 +55,36 @@ func (e *YouTubeError) Unwrap() error {
 }
 
 // CategorizeError inspects an error and returns a structured YouTubeError.
-// It attempts to identify specific error types from the YouTube API,
-// then falls back to string matching for common error messages.
+// It first consults the matchers added with RegisterErrorMatcher, then attempts to identify
+// specific error types from the YouTube API, and falls back to string matching for common
+// error messages.
 func CategorizeError(err error) *YouTubeError {
 	if err == nil {
 		return nil
 	}
 
+	// Errors that are already categorized are returned as they are, even when wrapped
+	var yErr *YouTubeError
+	if errors.As(err, &yErr) {
+		return yErr
+	}
+
+	// Deployment-specific matchers take precedence over the built-in categorization
+	if yErr, ok := matchCustomError(err); ok {
+		return yErr
+	}
+
+	// Prefer the structured status code and reasons of a wrapped googleapi.Error
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		if yErr := categorizeAPIError(apiErr, err); yErr != nil {
+			if yErr.Reason == "" {
+				yErr.Reason = apiErrorReason(apiErr)
+			}
+			return yErr
+		}
+	}
+
 	// Fallback to string matching for common error patterns
 	errStr := strings.ToLower(err.Error())
 
@@ -70,6 +102,7 @@ func CategorizeError(err error) *YouTubeError {
 			Message:       "Rate limit exceeded or quota exceeded",
 			Retryable:     true,
 			OriginalError: err,
+			RetryAfter:    parseRetryAfter(err),
 		}
 	case strings.Contains(errStr, "network") || strings.Contains(errStr, "timeout") || strings.Contains(errStr, "connection"):
 		return &YouTubeError{
@@ -91,6 +124,7 @@ func CategorizeError(err error) *YouTubeError {
 			Message:       "YouTube server error",
 			Retryable:     true,
 			OriginalError: err,
+			RetryAfter:    parseRetryAfter(err),
 		}
 	case strings.Contains(errStr, "language") || strings.Contains(errStr, "locale"):
 		return &YouTubeError{
@@ -116,6 +150,119 @@ func CategorizeError(err error) *YouTubeError {
 	}
 }
 
+// quotaReasons are googleapi error reasons that indicate a rate limit or exhausted quota,
+// even when the API reports them with a 403 status.
+var quotaReasons = map[string]bool{
+	"quotaExceeded":         true,
+	"rateLimitExceeded":     true,
+	"userRateLimitExceeded": true,
+	"dailyLimitExceeded":    true,
+	"uploadLimitExceeded":   true,
+}
+
+// categorizeAPIError maps a googleapi.Error to a YouTubeError using its HTTP status code
+// and reason fields. It returns nil for statuses it doesn't recognize so the caller can
+// fall back to string matching.
+func categorizeAPIError(apiErr *googleapi.Error, err error) *YouTubeError {
+	for _, item := range apiErr.Errors {
+		if quotaReasons[item.Reason] {
+			return &YouTubeError{
+				Type:          ErrorTypeRateLimit,
+				Message:       "Rate limit exceeded or quota exceeded",
+				Retryable:     true,
+				OriginalError: err,
+				RetryAfter:    parseRetryAfter(err),
+				Reason:        item.Reason,
+			}
+		}
+	}
+
+	switch {
+	case apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden:
+		return &YouTubeError{
+			Type:          ErrorTypeAuth,
+			Message:       "Authentication failed or insufficient permissions",
+			Retryable:     false,
+			OriginalError: err,
+		}
+	case apiErr.Code == http.StatusTooManyRequests:
+		return &YouTubeError{
+			Type:          ErrorTypeRateLimit,
+			Message:       "Rate limit exceeded or quota exceeded",
+			Retryable:     true,
+			OriginalError: err,
+			RetryAfter:    parseRetryAfter(err),
+		}
+	case apiErr.Code == http.StatusBadRequest:
+		return &YouTubeError{
+			Type:          ErrorTypeInvalid,
+			Message:       "Invalid request or malformed data",
+			Retryable:     false,
+			OriginalError: err,
+		}
+	case apiErr.Code >= http.StatusInternalServerError && apiErr.Code <= 599:
+		return &YouTubeError{
+			Type:          ErrorTypeServer,
+			Message:       "YouTube server error",
+			Retryable:     true,
+			OriginalError: err,
+			RetryAfter:    parseRetryAfter(err),
+		}
+	default:
+		return nil
+	}
+}
+
+// apiErrorReason returns the first reason reported in a googleapi.Error, or "" if there is none.
+func apiErrorReason(apiErr *googleapi.Error) string {
+	for _, item := range apiErr.Errors {
+		if item.Reason != "" {
+			return item.Reason
+		}
+	}
+	return ""
+}
+
+// retryAfterPattern matches retry hints such as "retry after 30s" or "Retry-After: 120".
+var retryAfterPattern = regexp.MustCompile(`(?i)retry[- _]?after[:=]?\s*([0-9][0-9a-z.]*)`)
+
+// parseRetryAfter extracts a retry hint from a wrapped googleapi.Error's Retry-After header
+// or, failing that, from the error message. It returns zero when no hint is present.
+func parseRetryAfter(err error) time.Duration {
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Header != nil {
+		if d := parseRetryAfterValue(apiErr.Header.Get("Retry-After")); d > 0 {
+			return d
+		}
+	}
+
+	if match := retryAfterPattern.FindStringSubmatch(err.Error()); match != nil {
+		return parseRetryAfterValue(strings.TrimRight(match[1], "."))
+	}
+	return 0
+}
+
+// parseRetryAfterValue parses a Retry-After value given as delay seconds, a Go duration
+// string (e.g. "1m30s"), or an HTTP date.
+func parseRetryAfterValue(value string) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return d
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := date.Sub(clock.Now()); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
 // NewLanguageError creates a specific error for language setting failures.
 func NewLanguageError(language string, originalErr error) *YouTubeError {
 	return &YouTubeError{
@@ -2,9 +2,13 @@ package publishing
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/googleapi"
 )
 
 func TestCategorizeError(t *testing.T) {
@@ -71,13 +75,6 @@ func TestCategorizeError(t *testing.T) {
 			expectedRetry:  false,
 			expectedMsg:    "Unknown error occurred",
 		},
-		{
-			name:           "Nil error",
-			inputError:     nil,
-			expectedType:   ErrorTypeUnknown,
-			expectedRetry:  false,
-			expectedMsg:    "Unknown error occurred",
-		},
 	}
 
 	for _, tt := range tests {
@@ -160,6 +157,10 @@ func TestYouTubeError_Unwrap(t *testing.T) {
 	assert.Equal(t, originalErr, youtubeErr.Unwrap())
 }
 
+func TestCategorizeError_Nil(t *testing.T) {
+	assert.Nil(t, CategorizeError(nil), "there is nothing to categorize without an error")
+}
+
 func TestCategorizeError_CaseInsensitive(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -184,6 +185,13 @@ func TestCategorizeError_CaseInsensitive(t *testing.T) {
 	}
 }
 
+func TestCategorizeError_KeepsCategorizedErrors(t *testing.T) {
+	original := &YouTubeError{Type: ErrorTypeInvalid, Message: "video file is empty", VideoID: "abc"}
+
+	assert.Same(t, original, CategorizeError(original))
+	assert.Same(t, original, CategorizeError(fmt.Errorf("uploading video.mp4: %w", original)))
+}
+
 func TestCategorizeError_MultipleKeywords(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -207,3 +215,183 @@ func TestCategorizeError_MultipleKeywords(t *testing.T) {
 		})
 	}
 }
+
+func TestCategorizeError_RetryAfter(t *testing.T) {
+	tests := []struct {
+		name               string
+		inputError         error
+		expectedRetryAfter time.Duration
+	}{
+		{
+			name:               "Quota error with retry hint in seconds",
+			inputError:         errors.New("quota exceeded, retry after 30 seconds"),
+			expectedRetryAfter: 30 * time.Second,
+		},
+		{
+			name:               "Rate limit error with duration hint",
+			inputError:         errors.New("rate limit exceeded (Retry-After: 1m30s)"),
+			expectedRetryAfter: 90 * time.Second,
+		},
+		{
+			name:               "Quota error without retry hint",
+			inputError:         errors.New("quota exceeded"),
+			expectedRetryAfter: 0,
+		},
+		{
+			name: "googleapi error with Retry-After header",
+			inputError: &googleapi.Error{
+				Code:    429,
+				Message: "Quota exceeded for quota metric",
+				Header:  http.Header{"Retry-After": []string{"120"}},
+			},
+			expectedRetryAfter: 2 * time.Minute,
+		},
+		{
+			name: "googleapi error without Retry-After header",
+			inputError: &googleapi.Error{
+				Code:    429,
+				Message: "Quota exceeded for quota metric",
+			},
+			expectedRetryAfter: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CategorizeError(tt.inputError)
+			assert.Equal(t, ErrorTypeRateLimit, result.Type)
+			assert.Equal(t, tt.expectedRetryAfter, result.RetryAfter)
+		})
+	}
+}
+
+func TestCategorizeError_GoogleAPIError(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputError    error
+		expectedType  ErrorType
+		expectedRetry bool
+	}{
+		{
+			name:          "401 unauthorized",
+			inputError:    &googleapi.Error{Code: 401, Message: "Request had invalid credentials"},
+			expectedType:  ErrorTypeAuth,
+			expectedRetry: false,
+		},
+		{
+			name:          "403 forbidden",
+			inputError:    &googleapi.Error{Code: 403, Message: "The caller does not have permission"},
+			expectedType:  ErrorTypeAuth,
+			expectedRetry: false,
+		},
+		{
+			name: "403 with quota reason",
+			inputError: &googleapi.Error{
+				Code:   403,
+				Errors: []googleapi.ErrorItem{{Reason: "quotaExceeded", Message: "The request cannot be completed"}},
+			},
+			expectedType:  ErrorTypeRateLimit,
+			expectedRetry: true,
+		},
+		{
+			name:          "429 too many requests",
+			inputError:    &googleapi.Error{Code: 429, Message: "Too many requests"},
+			expectedType:  ErrorTypeRateLimit,
+			expectedRetry: true,
+		},
+		{
+			name:          "400 bad request",
+			inputError:    &googleapi.Error{Code: 400, Message: "Missing snippet"},
+			expectedType:  ErrorTypeInvalid,
+			expectedRetry: false,
+		},
+		{
+			name:          "500 internal error",
+			inputError:    &googleapi.Error{Code: 500, Message: "Backend error"},
+			expectedType:  ErrorTypeServer,
+			expectedRetry: true,
+		},
+		{
+			name:          "503 service unavailable",
+			inputError:    &googleapi.Error{Code: 503, Message: "Service unavailable"},
+			expectedType:  ErrorTypeServer,
+			expectedRetry: true,
+		},
+		{
+			name:          "Wrapped googleapi error",
+			inputError:    fmt.Errorf("insert failed: %w", &googleapi.Error{Code: 401}),
+			expectedType:  ErrorTypeAuth,
+			expectedRetry: false,
+		},
+		{
+			name:          "Unrecognized status falls back to string matching",
+			inputError:    &googleapi.Error{Code: 404, Message: "Video not found"},
+			expectedType:  ErrorTypeUpload,
+			expectedRetry: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CategorizeError(tt.inputError)
+			assert.Equal(t, tt.expectedType, result.Type)
+			assert.Equal(t, tt.expectedRetry, result.Retryable)
+			assert.Equal(t, tt.inputError, result.OriginalError)
+		})
+	}
+}
+
+func TestCategorizeError_Reason(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputError     error
+		expectedType   ErrorType
+		expectedReason string
+	}{
+		{
+			name:           "Daily quota",
+			inputError:     &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "quotaExceeded"}}},
+			expectedType:   ErrorTypeRateLimit,
+			expectedReason: "quotaExceeded",
+		},
+		{
+			name:           "Upload limit",
+			inputError:     fmt.Errorf("insert failed: %w", &googleapi.Error{Code: 400, Errors: []googleapi.ErrorItem{{Reason: "uploadLimitExceeded"}}}),
+			expectedType:   ErrorTypeRateLimit,
+			expectedReason: "uploadLimitExceeded",
+		},
+		{
+			name: "Quota reason after another reason",
+			inputError: &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{
+				{Reason: "forbidden"},
+				{Reason: "rateLimitExceeded"},
+			}},
+			expectedType:   ErrorTypeRateLimit,
+			expectedReason: "rateLimitExceeded",
+		},
+		{
+			name:           "Non-quota reason",
+			inputError:     &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}},
+			expectedType:   ErrorTypeAuth,
+			expectedReason: "forbidden",
+		},
+		{
+			name:         "No reason",
+			inputError:   &googleapi.Error{Code: 429},
+			expectedType: ErrorTypeRateLimit,
+		},
+		{
+			name:         "Plain error",
+			inputError:   errors.New("quota exceeded"),
+			expectedType: ErrorTypeRateLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CategorizeError(tt.inputError)
+			assert.Equal(t, tt.expectedType, result.Type)
+			assert.Equal(t, tt.expectedReason, result.Reason)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package publishing
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+)
+
+// hugoPostTemplate renders a Hugo post with TOML front matter.
+var hugoPostTemplate = template.Must(template.New("post").Funcs(template.FuncMap{
+	"toml": tomlString,
+}).Parse(`+++
+title = {{ toml .Title }}
+{{- if .Date }}
+date = {{ .Date }}
+{{- end }}
+draft = false
+{{- if .Tags }}
+tags = [{{ range $i, $tag := .Tags }}{{ if $i }}, {{ end }}{{ toml $tag }}{{ end }}]
+{{- end }}
+{{- if .Description }}
+description = {{ toml .Description }}
+{{- end }}
++++
+
+{{ if .Description }}{{ .Description }}{{ else }}FIXME:{{ end }}
+
+<!--more-->
+
+{{ if .VideoID }}{{ "{{<" }} youtube {{ .VideoID }} {{ ">}}" }}{{ else }}{{ "{{<" }} youtube FIXME: {{ ">}}" }}{{ end }}
+{{- if .RelatedVideos }}
+
+## Related Videos
+{{ range .RelatedVideos }}
+* {{ . }}
+{{- end }}
+{{- end }}
+`))
+
+// hugoPostData holds the values rendered by hugoPostTemplate.
+type hugoPostData struct {
+	Title         string
+	Date          string
+	Tags          []string
+	Description   string
+	VideoID       string
+	RelatedVideos []string
+}
+
+// GeneratePost renders the Hugo post for a video: front matter with the title, publish date,
+// tags and description, followed by the embedded YouTube video and related videos.
+// Missing optional fields are left out (or marked FIXME: in the body); the title falls back
+// to the video name, and an error is returned only when neither is set.
+func GeneratePost(video *storage.Video) (string, error) {
+	if video == nil {
+		return "", fmt.Errorf("video metadata is required to generate a Hugo post")
+	}
+
+	data := hugoPostData{
+		Title:       strings.TrimSpace(video.Title),
+		Description: strings.TrimSpace(video.Description),
+		VideoID:     strings.TrimSpace(video.VideoId),
+	}
+	if data.Title == "" {
+		data.Title = strings.TrimSpace(video.Name)
+	}
+	if data.Title == "" {
+		return "", fmt.Errorf("video has neither a title nor a name to use for the Hugo post")
+	}
+
+	date, err := video.ParsedPublishDate()
+	if err != nil {
+		return "", err
+	}
+	if !date.IsZero() {
+		data.Date = date.Format("2006-01-02T15:04:05+00:00")
+	}
+
+	for _, tag := range strings.Split(video.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			data.Tags = append(data.Tags, tag)
+		}
+	}
+	for _, related := range strings.Split(video.RelatedVideos, "\n") {
+		if related = strings.TrimSpace(related); related != "" && related != "N/A" {
+			data.RelatedVideos = append(data.RelatedVideos, related)
+		}
+	}
+
+	var post strings.Builder
+	if err := hugoPostTemplate.Execute(&post, data); err != nil {
+		return "", fmt.Errorf("failed to render Hugo post for %s: %w", data.Title, err)
+	}
+	return post.String(), nil
+}
+
+// tomlString quotes s as a TOML basic string.
+func tomlString(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return `"` + replacer.Replace(s) + `"`
+}
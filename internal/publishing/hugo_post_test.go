@@ -0,0 +1,72 @@
+package publishing
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files in testdata")
+
+// assertGolden compares actual with testdata/<name>, rewriting the file when -update is set.
+func assertGolden(t *testing.T, name, actual string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(path, []byte(actual), 0644))
+	}
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), actual)
+}
+
+func TestGeneratePost(t *testing.T) {
+	tests := []struct {
+		name   string
+		video  *storage.Video
+		golden string
+	}{
+		{
+			name: "All fields",
+			video: &storage.Video{
+				Name:          "crossplane-intro",
+				Title:         `Crossplane "Compositions" Explained`,
+				Date:          "2025-01-15T10:30",
+				Tags:          "crossplane, kubernetes,, platform engineering",
+				Description:   "Everything you need to know about Crossplane compositions.",
+				VideoId:       "dQw4w9WgXcQ",
+				RelatedVideos: "Crossplane Providers: https://youtu.be/abcdefghijk\n\nN/A\nArgo CD: https://youtu.be/bcdefghijkl",
+			},
+			golden: "hugo_post_full.golden",
+		},
+		{
+			name:   "Missing optional fields",
+			video:  &storage.Video{Name: "Untitled Idea"},
+			golden: "hugo_post_minimal.golden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, err := GeneratePost(tt.video)
+			require.NoError(t, err)
+			assertGolden(t, tt.golden, post)
+		})
+	}
+}
+
+func TestGeneratePost_Errors(t *testing.T) {
+	_, err := GeneratePost(nil)
+	assert.Error(t, err)
+
+	_, err = GeneratePost(&storage.Video{})
+	assert.Error(t, err, "a post needs a title or name")
+
+	_, err = GeneratePost(&storage.Video{Title: "Title", Date: "2025/01/15"})
+	assert.Error(t, err, "malformed publish dates should be reported")
+}
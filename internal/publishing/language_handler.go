@@ -1,39 +1,225 @@
 package publishing
 
 import (
+	"fmt"
+	"strings"
+	"sync"
+
 	"devopstoolkit/youtube-automation/internal/constants"
 	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/api/youtube/v3"
 )
 
+// LanguageResult describes which languages were requested for a video and which were applied.
+type LanguageResult struct {
+	RequestedLanguage string // Language requested by the video metadata (or the default when unset)
+	AppliedLanguage   string // Language set on the YouTube video
+	RequestedAudio    string // Audio language requested by the video metadata (or the default when unset)
+	AppliedAudio      string // Audio language set on the YouTube video
+	FellBack          bool   // Whether either language had to fall back to the default
+}
+
+// fallbackLanguage is the default used when callers pass an empty default language
+var (
+	fallbackLanguageMu sync.RWMutex
+	fallbackLanguage   = constants.DefaultLanguage
+)
+
+// SetDefaultLanguage changes the language used when callers pass an empty default language,
+// for channels that don't publish in English. The code must be a valid language code.
+func SetDefaultLanguage(code string) error {
+	code = constants.NormalizeLanguage(code)
+	if !constants.IsValidLanguage(code) {
+		return fmt.Errorf("invalid default language code '%s'", code)
+	}
+	fallbackLanguageMu.Lock()
+	defer fallbackLanguageMu.Unlock()
+	fallbackLanguage = code
+	return nil
+}
+
+// GetDefaultLanguage returns the language set by SetDefaultLanguage, constants.DefaultLanguage
+// unless it was changed.
+func GetDefaultLanguage() string {
+	fallbackLanguageMu.RLock()
+	defer fallbackLanguageMu.RUnlock()
+	return fallbackLanguage
+}
+
+// GetLanguageWithFallbackChain returns the language and audio language to use for the video,
+// taking for each the first valid code among the video's own code and then the codes of chain,
+// in order, such as the channel default followed by a last resort. constants.DefaultLanguage is
+// used when none is valid. The tier that provided each language is logged, and the fallback
+// metric is only recorded when the video's own code was set but invalid.
+func GetLanguageWithFallbackChain(video *storage.Video, chain ...string) (string, string) {
+	if video == nil {
+		video = &storage.Video{}
+	}
+	log := LogForVideo(video)
+	language := resolveLanguageChain(log, "language", video.Language, chain)
+	audioLanguage := resolveLanguageChain(log, "audio language", video.AudioLanguage, chain)
+	return language, audioLanguage
+}
+
+// resolveLanguageChain returns the first valid code among own and chain, kind naming the field
+// in logs. Tier 0 is the video's own code and tier N the Nth code of the chain.
+func resolveLanguageChain(log *logrus.Entry, kind, own string, chain []string) string {
+	if own = constants.NormalizeLanguage(own); own != "" {
+		if constants.IsValidLanguage(own) {
+			log.WithField("tier", 0).Debugf("Using the video's %s '%s'", kind, own)
+			return own
+		}
+		log.Warnf("Invalid %s code '%s', trying the fallback chain", kind, own)
+		YouTubeMetrics.IncLanguageFallback()
+		YouTubeMetrics.RecordLanguageFallback(own)
+	}
+
+	for i, candidate := range chain {
+		candidate = constants.NormalizeLanguage(candidate)
+		if constants.IsValidLanguage(candidate) {
+			log.WithField("tier", i+1).Infof("Using %s '%s' from fallback tier %d", kind, candidate, i+1)
+			return candidate
+		}
+		if candidate != "" {
+			log.WithField("tier", i+1).Warnf("Skipping invalid fallback %s '%s'", kind, candidate)
+		}
+	}
+
+	log.Warnf("No valid %s in the fallback chain, using '%s'", kind, constants.DefaultLanguage)
+	return constants.DefaultLanguage
+}
+
+// CheckLanguageConsistency compares the video's audio language with the languages of the caption
+// tracks to be uploaded for it and returns a human-readable warning for every mismatch YouTube
+// would warn about: invalid codes, and no caption track in the audio language. Regional variants
+// of the same language, such as "en" and "en-GB", match. The warnings are also logged; they
+// are not errors, so the upload can go ahead regardless. No captions means no warnings.
+func CheckLanguageConsistency(video *storage.Video, captionLangs []string) []string {
+	if len(captionLangs) == 0 {
+		return nil
+	}
+	if video == nil {
+		video = &storage.Video{}
+	}
+
+	var warnings []string
+	audioLanguage := constants.NormalizeLanguage(video.GetAudioLanguage(GetDefaultLanguage()))
+	if !constants.IsValidLanguage(audioLanguage) {
+		warnings = append(warnings, fmt.Sprintf("audio language '%s' is not a valid language code", audioLanguage))
+	}
+
+	matchesAudio := false
+	var languages []string
+	for _, code := range captionLangs {
+		code = constants.NormalizeLanguage(code)
+		languages = append(languages, code)
+		if !constants.IsValidLanguage(code) {
+			warnings = append(warnings, fmt.Sprintf("caption language '%s' is not a valid language code", code))
+			continue
+		}
+		if baseLanguage(code) == baseLanguage(audioLanguage) {
+			matchesAudio = true
+		}
+	}
+	if !matchesAudio && constants.IsValidLanguage(audioLanguage) {
+		warnings = append(warnings, fmt.Sprintf("no caption track matches the audio language '%s', captions are in %s",
+			audioLanguage, strings.Join(languages, ", ")))
+	}
+
+	log := LogForVideo(video)
+	for _, warning := range warnings {
+		log.Warn("Language inconsistency: " + warning)
+	}
+	return warnings
+}
+
+// baseLanguage returns the primary subtag of a normalized language code, "pt" for "pt-BR".
+func baseLanguage(code string) string {
+	base, _, _ := strings.Cut(code, "-")
+	return base
+}
+
 // ValidateAndSetLanguage validates the language and sets it in the YouTube video object.
 // It implements proper error handling with fallback mechanisms.
 func ValidateAndSetLanguage(youtubeVideo *youtube.Video, video *storage.Video, defaultLanguage string) error {
+	_, err := ApplyLanguage(youtubeVideo, video, defaultLanguage)
+	return err
+}
+
+// ValidateAndSetLanguageStrict works like ValidateAndSetLanguage but never falls back to the
+// default: an invalid language or audio language code is returned as a language error and the
+// YouTube video object is left untouched, so the upload fails instead of going out in English.
+func ValidateAndSetLanguageStrict(youtubeVideo *youtube.Video, video *storage.Video, defaultLanguage string) error {
+	_, err := applyLanguage(youtubeVideo, video, defaultLanguage, true)
+	return err
+}
+
+// ApplyLanguage works like ValidateAndSetLanguage but also reports the requested and applied
+// languages, so callers can tell the user when a fallback to the default happened.
+func ApplyLanguage(youtubeVideo *youtube.Video, video *storage.Video, defaultLanguage string) (LanguageResult, error) {
+	return applyLanguage(youtubeVideo, video, defaultLanguage, false)
+}
+
+// applyLanguage implements ApplyLanguage, failing on invalid codes instead of falling back
+// when strict is set.
+func applyLanguage(youtubeVideo *youtube.Video, video *storage.Video, defaultLanguage string, strict bool) (LanguageResult, error) {
+	log := LogForVideo(video)
+	if video == nil {
+		// A missing video has no language preferences, so the defaults apply
+		video = &storage.Video{}
+	}
+	if defaultLanguage == "" {
+		defaultLanguage = GetDefaultLanguage()
+	}
+
 	// Get the language to use (from video metadata or fallback to default)
-	language := video.GetLanguage(defaultLanguage)
-	audioLanguage := video.GetAudioLanguage(defaultLanguage)
+	language := constants.NormalizeLanguage(video.GetLanguage(defaultLanguage))
+	audioLanguage := constants.NormalizeLanguage(video.GetAudioLanguage(defaultLanguage))
+	result := LanguageResult{
+		RequestedLanguage: language,
+		RequestedAudio:    audioLanguage,
+	}
 
 	// Increment validation counter
 	YouTubeMetrics.IncLanguageValidation()
 
+	// Per-language metrics are recorded against the requested code, before any fallback
+	requestedLanguage := language
+	fellBack := false
+
+	if strict {
+		for _, code := range []string{language, audioLanguage} {
+			if !constants.IsValidLanguage(code) {
+				log.Errorf("Invalid language code '%s', strict language mode forbids falling back to '%s'", code, defaultLanguage)
+				YouTubeMetrics.IncLanguageSetFailure()
+				return result, NewLanguageError(code, fmt.Errorf("'%s' is not a valid language code", code))
+			}
+		}
+	}
+
 	// Validate language codes
 	if !constants.IsValidLanguage(language) {
-		LogYouTubeWarn("Invalid language code '%s', falling back to default '%s'", language, defaultLanguage)
+		log.Warnf("Invalid language code '%s', falling back to default '%s'", language, defaultLanguage)
 		YouTubeMetrics.IncLanguageFallback()
+		YouTubeMetrics.RecordLanguageFallback(language)
 		language = defaultLanguage
+		fellBack = true
 	}
 
 	if !constants.IsValidLanguage(audioLanguage) {
-		LogYouTubeWarn("Invalid audio language code '%s', falling back to default '%s'", audioLanguage, defaultLanguage)
+		log.Warnf("Invalid audio language code '%s', falling back to default '%s'", audioLanguage, defaultLanguage)
 		YouTubeMetrics.IncLanguageFallback()
+		YouTubeMetrics.RecordLanguageFallback(audioLanguage)
 		audioLanguage = defaultLanguage
+		result.FellBack = true
 	}
 
 	// Set language in video object with error handling
 	err := setLanguageSafely(youtubeVideo, language, audioLanguage)
 	if err != nil {
 		// Log the error but don't fail the upload
-		LogLanguageSetting(language, false, true, err)
+		logLanguageSetting(log, language, false, true, err)
 		YouTubeMetrics.IncLanguageSetFailure()
 		
 		// Fallback to default language
@@ -43,19 +229,27 @@ func ValidateAndSetLanguage(youtubeVideo *youtube.Video, video *storage.Video, d
 			LogYouTubeError(NewLanguageError(defaultLanguage, fallbackErr), "Failed to set fallback language")
 			YouTubeMetrics.IncLanguageSetFailure()
 		} else {
-			LogLanguageSetting(defaultLanguage, true, true, nil)
+			logLanguageSetting(log, defaultLanguage, true, true, nil)
 			YouTubeMetrics.IncLanguageSetSuccess()
 		}
+		fellBack = true
 	} else {
-		LogLanguageSetting(language, true, false, nil)
+		logLanguageSetting(log, language, true, false, nil)
 		YouTubeMetrics.IncLanguageSetSuccess()
+		if !fellBack {
+			YouTubeMetrics.RecordLanguageSuccess(requestedLanguage)
+		}
 	}
 
 	// Store the applied languages back to the video struct
 	video.AppliedLanguage = language
 	video.AppliedAudioLanguage = audioLanguage
 
-	return nil // Never fail the upload due to language setting issues
+	result.AppliedLanguage = language
+	result.AppliedAudio = audioLanguage
+	result.FellBack = result.FellBack || fellBack
+
+	return result, nil // Never fail the upload due to language setting issues
 }
 
 // setLanguageSafely sets the language fields on the YouTube video object.
@@ -86,21 +280,31 @@ func ValidateLanguageCode(language string) error {
 }
 
 // GetLanguageWithFallback returns the language to use with proper fallback logic.
+// An empty defaultLanguage uses the one set by SetDefaultLanguage.
 func GetLanguageWithFallback(video *storage.Video, defaultLanguage string) (string, string) {
-	language := video.GetLanguage(defaultLanguage)
-	audioLanguage := video.GetAudioLanguage(defaultLanguage)
+	if defaultLanguage == "" {
+		defaultLanguage = GetDefaultLanguage()
+	}
+	language := constants.NormalizeLanguage(video.GetLanguage(defaultLanguage))
+	audioLanguage := constants.NormalizeLanguage(video.GetAudioLanguage(defaultLanguage))
 
-	// Validate and fallback if necessary
+	// Validate and fallback if necessary, recording either outcome against the requested code
 	if !constants.IsValidLanguage(language) {
 		LogYouTubeWarn("Invalid language code '%s', using fallback '%s'", language, defaultLanguage)
 		YouTubeMetrics.IncLanguageFallback()
+		YouTubeMetrics.RecordLanguageFallback(language)
 		language = defaultLanguage
+	} else {
+		YouTubeMetrics.RecordLanguageSuccess(language)
 	}
 
 	if !constants.IsValidLanguage(audioLanguage) {
 		LogYouTubeWarn("Invalid audio language code '%s', using fallback '%s'", audioLanguage, defaultLanguage)
 		YouTubeMetrics.IncLanguageFallback()
+		YouTubeMetrics.RecordLanguageFallback(audioLanguage)
 		audioLanguage = defaultLanguage
+	} else {
+		YouTubeMetrics.RecordLanguageSuccess(audioLanguage)
 	}
 
 	return language, audioLanguage
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidateAndSetLanguage(t *testing.T) {
@@ -64,6 +65,17 @@ func TestValidateAndSetLanguage(t *testing.T) {
 			expectedAudioLang: "fr",
 			expectError:       false,
 		},
+		{
+			name: "Mixed-case codes are normalized",
+			video: &storage.Video{
+				Language:      " EN ",
+				AudioLanguage: "pt-br",
+			},
+			defaultLanguage:   "fr",
+			expectedLanguage:  "en",
+			expectedAudioLang: "pt-BR",
+			expectError:       false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -301,3 +313,285 @@ func TestValidateAndSetLanguage_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAndSetLanguage_PerLanguageMetrics(t *testing.T) {
+	YouTubeMetrics.Reset()
+
+	video := &storage.Video{
+		Language:      "klingon",
+		AudioLanguage: "de",
+	}
+
+	err := ValidateAndSetLanguage(&youtube.Video{}, video, "en")
+	assert.NoError(t, err)
+
+	// Fallbacks are recorded against the originally requested code, not the default
+	assert.Equal(t, map[string]int64{"klingon": 1}, YouTubeMetrics.GetFallbacksByLanguage())
+	assert.Empty(t, YouTubeMetrics.GetSuccessesByLanguage())
+
+	YouTubeMetrics.Reset()
+
+	err = ValidateAndSetLanguage(&youtube.Video{}, &storage.Video{Language: "DE"}, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"de": 1}, YouTubeMetrics.GetSuccessesByLanguage())
+	assert.Empty(t, YouTubeMetrics.GetFallbacksByLanguage())
+
+	YouTubeMetrics.Reset()
+
+	language, audioLanguage := GetLanguageWithFallback(&storage.Video{Language: "bogus", AudioLanguage: "nope"}, "en")
+	assert.Equal(t, "en", language)
+	assert.Equal(t, "en", audioLanguage)
+	assert.Equal(t, map[string]int64{"bogus": 1, "nope": 1}, YouTubeMetrics.GetFallbacksByLanguage())
+	assert.Empty(t, YouTubeMetrics.GetSuccessesByLanguage())
+
+	YouTubeMetrics.Reset()
+
+	language, audioLanguage = GetLanguageWithFallback(&storage.Video{Language: "FR", AudioLanguage: "nope"}, "en")
+	assert.Equal(t, "fr", language)
+	assert.Equal(t, "en", audioLanguage)
+	assert.Equal(t, map[string]int64{"fr": 1}, YouTubeMetrics.GetSuccessesByLanguage())
+	assert.Equal(t, map[string]int64{"nope": 1}, YouTubeMetrics.GetFallbacksByLanguage())
+}
+
+func TestApplyLanguage_Result(t *testing.T) {
+	tests := []struct {
+		name     string
+		video    *storage.Video
+		expected LanguageResult
+	}{
+		{
+			name:  "Valid codes are applied as requested",
+			video: &storage.Video{Language: "es", AudioLanguage: "ES"},
+			expected: LanguageResult{
+				RequestedLanguage: "es", AppliedLanguage: "es",
+				RequestedAudio: "es", AppliedAudio: "es",
+				FellBack: false,
+			},
+		},
+		{
+			name:  "Invalid language falls back",
+			video: &storage.Video{Language: "klingon", AudioLanguage: "es"},
+			expected: LanguageResult{
+				RequestedLanguage: "klingon", AppliedLanguage: "en",
+				RequestedAudio: "es", AppliedAudio: "es",
+				FellBack: true,
+			},
+		},
+		{
+			name:  "Invalid audio language falls back",
+			video: &storage.Video{Language: "es", AudioLanguage: "xx"},
+			expected: LanguageResult{
+				RequestedLanguage: "es", AppliedLanguage: "es",
+				RequestedAudio: "xx", AppliedAudio: "en",
+				FellBack: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			YouTubeMetrics.Reset()
+			youtubeVideo := &youtube.Video{}
+
+			result, err := ApplyLanguage(youtubeVideo, tt.video, "en")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.expected.AppliedLanguage, youtubeVideo.Snippet.DefaultLanguage)
+			assert.Equal(t, tt.expected.AppliedAudio, youtubeVideo.Snippet.DefaultAudioLanguage)
+			assert.Equal(t, tt.expected.AppliedLanguage, tt.video.AppliedLanguage)
+		})
+	}
+}
+
+// useDefaultLanguage sets the package default language for the duration of a test.
+func useDefaultLanguage(t *testing.T, code string) {
+	t.Helper()
+	original := GetDefaultLanguage()
+	require.NoError(t, SetDefaultLanguage(code))
+	t.Cleanup(func() {
+		require.NoError(t, SetDefaultLanguage(original))
+	})
+}
+
+func TestSetDefaultLanguage(t *testing.T) {
+	assert.Equal(t, "en", GetDefaultLanguage())
+
+	err := SetDefaultLanguage("invalid")
+	assert.Error(t, err)
+	assert.Equal(t, "en", GetDefaultLanguage(), "an invalid code must not change the default")
+
+	useDefaultLanguage(t, "PT_br")
+	assert.Equal(t, "pt-BR", GetDefaultLanguage())
+}
+
+func TestGetLanguageWithFallback_PackageDefault(t *testing.T) {
+	useDefaultLanguage(t, "de")
+
+	language, audioLanguage := GetLanguageWithFallback(&storage.Video{Language: "invalid"}, "")
+	assert.Equal(t, "de", language)
+	assert.Equal(t, "de", audioLanguage)
+
+	language, _ = GetLanguageWithFallback(&storage.Video{Language: "invalid"}, "fr")
+	assert.Equal(t, "fr", language, "an explicit default takes precedence")
+}
+
+func TestGetLanguageWithFallbackChain(t *testing.T) {
+	YouTubeMetrics.Reset()
+	logs := captureLogs(t)
+
+	video := &storage.Video{Language: "klingon", AudioLanguage: "DE"}
+	language, audioLanguage := GetLanguageWithFallbackChain(video, "elvish", "es", "en")
+
+	assert.Equal(t, "es", language, "the first valid tier of the chain is used")
+	assert.Equal(t, "de", audioLanguage, "a valid code of the video wins")
+	assert.Equal(t, int64(1), YouTubeMetrics.GetLanguageFallback())
+	assert.Equal(t, map[string]int64{"klingon": 1}, YouTubeMetrics.GetFallbacksByLanguage())
+
+	var tiers []float64
+	for _, entry := range decodeLogLines(t, logs) {
+		if entry["msg"] == "Using language 'es' from fallback tier 2" {
+			tiers = append(tiers, entry["tier"].(float64))
+		}
+	}
+	assert.Equal(t, []float64{2}, tiers, "the tier used must be logged")
+}
+
+func TestGetLanguageWithFallbackChain_UnsetLanguage(t *testing.T) {
+	YouTubeMetrics.Reset()
+
+	language, audioLanguage := GetLanguageWithFallbackChain(&storage.Video{}, "", "fr")
+
+	assert.Equal(t, "fr", language)
+	assert.Equal(t, "fr", audioLanguage)
+	assert.Zero(t, YouTubeMetrics.GetLanguageFallback(), "an unset language is not a fallback")
+}
+
+func TestGetLanguageWithFallbackChain_ExhaustedChain(t *testing.T) {
+	YouTubeMetrics.Reset()
+
+	language, audioLanguage := GetLanguageWithFallbackChain(&storage.Video{Language: "klingon"}, "elvish")
+
+	assert.Equal(t, "en", language)
+	assert.Equal(t, "en", audioLanguage)
+
+	language, _ = GetLanguageWithFallbackChain(nil)
+	assert.Equal(t, "en", language)
+}
+
+func TestCheckLanguageConsistency(t *testing.T) {
+	tests := []struct {
+		name             string
+		video            *storage.Video
+		captionLangs     []string
+		expectedWarnings []string
+	}{
+		{
+			name:         "Captions include the audio language",
+			video:        &storage.Video{AudioLanguage: "en"},
+			captionLangs: []string{"es", "en"},
+		},
+		{
+			name:         "Regional variant of the audio language",
+			video:        &storage.Video{AudioLanguage: "pt-BR"},
+			captionLangs: []string{"pt"},
+		},
+		{
+			name:         "Audio language defaults to the package default",
+			video:        &storage.Video{},
+			captionLangs: []string{"EN"},
+		},
+		{
+			name:  "No captions",
+			video: &storage.Video{AudioLanguage: "de"},
+		},
+		{
+			name:             "Captions in unrelated languages",
+			video:            &storage.Video{AudioLanguage: "de"},
+			captionLangs:     []string{"en", "fr"},
+			expectedWarnings: []string{"no caption track matches the audio language 'de', captions are in en, fr"},
+		},
+		{
+			name:         "Invalid caption language",
+			video:        &storage.Video{AudioLanguage: "de"},
+			captionLangs: []string{"de", "klingon"},
+			expectedWarnings: []string{
+				"caption language 'klingon' is not a valid language code",
+			},
+		},
+		{
+			name:         "Invalid audio language",
+			video:        &storage.Video{AudioLanguage: "klingon"},
+			captionLangs: []string{"en"},
+			expectedWarnings: []string{
+				"audio language 'klingon' is not a valid language code",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := captureLogs(t)
+
+			warnings := CheckLanguageConsistency(tt.video, tt.captionLangs)
+
+			assert.Equal(t, tt.expectedWarnings, warnings)
+			for _, warning := range tt.expectedWarnings {
+				assert.Contains(t, logs.String(), warning, "warnings must surface in the logs")
+			}
+		})
+	}
+}
+
+func TestApplyLanguage_PackageDefault(t *testing.T) {
+	useDefaultLanguage(t, "es")
+
+	youtubeVideo := &youtube.Video{}
+	result, err := ApplyLanguage(youtubeVideo, &storage.Video{}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "es", result.AppliedLanguage)
+	assert.Equal(t, "es", youtubeVideo.Snippet.DefaultLanguage)
+}
+
+func TestValidateAndSetLanguageStrict(t *testing.T) {
+	tests := []struct {
+		name            string
+		video           *storage.Video
+		invalidLanguage string
+	}{
+		{name: "Invalid language", video: &storage.Video{Language: "klingon", AudioLanguage: "en"}, invalidLanguage: "klingon"},
+		{name: "Invalid audio language", video: &storage.Video{Language: "es", AudioLanguage: "xx"}, invalidLanguage: "xx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			YouTubeMetrics.Reset()
+			youtubeVideo := &youtube.Video{Snippet: &youtube.VideoSnippet{}}
+
+			err := ValidateAndSetLanguageStrict(youtubeVideo, tt.video, "en")
+
+			var yErr *YouTubeError
+			require.ErrorAs(t, err, &yErr)
+			assert.Equal(t, ErrorTypeLanguage, yErr.Type)
+			assert.Equal(t, tt.invalidLanguage, yErr.Language)
+			assert.Empty(t, youtubeVideo.Snippet.DefaultLanguage, "nothing is set when strict mode fails")
+			assert.Empty(t, tt.video.AppliedLanguage)
+			assert.Zero(t, YouTubeMetrics.GetLanguageFallback())
+			assert.Equal(t, int64(1), YouTubeMetrics.GetLanguageSetFailure())
+		})
+	}
+
+	t.Run("Valid languages", func(t *testing.T) {
+		youtubeVideo := &youtube.Video{}
+		video := &storage.Video{Language: "es", AudioLanguage: "en"}
+
+		require.NoError(t, ValidateAndSetLanguageStrict(youtubeVideo, video, "en"))
+		assert.Equal(t, "es", youtubeVideo.Snippet.DefaultLanguage)
+		assert.Equal(t, "en", youtubeVideo.Snippet.DefaultAudioLanguage)
+	})
+
+	t.Run("Lenient mode still falls back", func(t *testing.T) {
+		youtubeVideo := &youtube.Video{}
+		require.NoError(t, ValidateAndSetLanguage(youtubeVideo, &storage.Video{Language: "klingon"}, "en"))
+		assert.Equal(t, "en", youtubeVideo.Snippet.DefaultLanguage)
+	})
+}
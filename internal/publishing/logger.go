@@ -2,8 +2,11 @@ package publishing
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
+	"devopstoolkit/youtube-automation/internal/storage"
 	"github.com/sirupsen/logrus"
 )
 
@@ -22,10 +25,49 @@ func SetLogLevel(level logrus.Level) {
 	youtubeLog.SetLevel(level)
 }
 
+// SetLogLevelFromString sets the log level from its name (e.g. "debug", "warn"),
+// returning an error for unknown levels and leaving the current level unchanged.
+func SetLogLevelFromString(level string) error {
+	parsed, err := logrus.ParseLevel(strings.TrimSpace(level))
+	if err != nil {
+		return fmt.Errorf("invalid log level %q: %w", level, err)
+	}
+	SetLogLevel(parsed)
+	return nil
+}
+
+// ConfigureLogger changes where YouTube logs are written and how they are formatted.
+// A nil writer or formatter leaves the corresponding setting unchanged.
+func ConfigureLogger(out io.Writer, formatter logrus.Formatter) {
+	if out != nil {
+		youtubeLog.SetOutput(out)
+	}
+	if formatter != nil {
+		youtubeLog.SetFormatter(formatter)
+	}
+}
+
+// SetTextFormat switches YouTube logs from JSON to human-readable text, keeping the current output.
+func SetTextFormat() {
+	ConfigureLogger(nil, &logrus.TextFormatter{})
+}
+
 func baseEntry() *logrus.Entry {
 	return youtubeLog.WithField("component", "youtube")
 }
 
+// LogForVideo returns a log entry tagged with the video's name and category so concurrent
+// operations can be told apart. A nil video yields the plain component entry.
+func LogForVideo(video *storage.Video) *logrus.Entry {
+	if video == nil {
+		return baseEntry()
+	}
+	return baseEntry().WithFields(logrus.Fields{
+		"video_name": video.Name,
+		"category":   video.Category,
+	})
+}
+
 // LogYouTubeError logs a categorized YouTube error with structured fields.
 func LogYouTubeError(yErr *YouTubeError, message string) {
 	if yErr == nil {
@@ -45,6 +87,9 @@ func LogYouTubeError(yErr *YouTubeError, message string) {
 	if yErr.Language != "" {
 		fields["language"] = yErr.Language
 	}
+	if yErr.Reason != "" {
+		fields["reason"] = yErr.Reason
+	}
 
 	entry := baseEntry().WithFields(fields)
 
@@ -72,13 +117,18 @@ func LogYouTubeDebug(message string, args ...interface{}) {
 
 // LogLanguageSetting logs language setting operations with context.
 func LogLanguageSetting(language string, success bool, fallback bool, err error) {
+	logLanguageSetting(baseEntry(), language, success, fallback, err)
+}
+
+// logLanguageSetting logs a language setting operation on top of the given entry.
+func logLanguageSetting(base *logrus.Entry, language string, success bool, fallback bool, err error) {
 	fields := logrus.Fields{
 		"language": language,
 		"success":  success,
 		"fallback": fallback,
 	}
 
-	entry := baseEntry().WithFields(fields)
+	entry := base.WithFields(fields)
 
 	if err != nil {
 		entry.WithError(err).Error("Language setting failed")
@@ -0,0 +1,148 @@
+package publishing
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+// captureLogs redirects YouTube logs to a buffer for the duration of a test,
+// restoring the original output, formatter and level afterwards.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	originalOut, originalFormatter, originalLevel := youtubeLog.Out, youtubeLog.Formatter, youtubeLog.GetLevel()
+	t.Cleanup(func() {
+		youtubeLog.SetOutput(originalOut)
+		youtubeLog.SetFormatter(originalFormatter)
+		youtubeLog.SetLevel(originalLevel)
+	})
+
+	var buf bytes.Buffer
+	ConfigureLogger(&buf, nil)
+	return &buf
+}
+
+func TestConfigureLogger_DefaultsToJSON(t *testing.T) {
+	buf := captureLogs(t)
+
+	LogYouTubeInfo("uploading %s", "video")
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "uploading video", entry["msg"])
+	assert.Equal(t, "youtube", entry["component"])
+}
+
+func TestConfigureLogger_TextFormat(t *testing.T) {
+	buf := captureLogs(t)
+
+	SetTextFormat()
+	LogYouTubeInfo("uploading %s", "video")
+
+	output := buf.String()
+	assert.Contains(t, output, `msg="uploading video"`)
+	assert.Contains(t, output, "component=youtube")
+	assert.False(t, json.Valid(buf.Bytes()), "text output should not be JSON")
+}
+
+func TestConfigureLogger_CustomFormatter(t *testing.T) {
+	buf := captureLogs(t)
+
+	ConfigureLogger(nil, &logrus.TextFormatter{DisableQuote: true, DisableTimestamp: true})
+	LogYouTubeWarn("quota low")
+
+	assert.Equal(t, "level=warning msg=quota low component=youtube\n", buf.String())
+}
+
+// decodeLogLines parses each JSON log line written to buf.
+func decodeLogLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestLogYouTubeError_Reason(t *testing.T) {
+	buf := captureLogs(t)
+
+	LogYouTubeError(CategorizeError(&googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "uploadLimitExceeded"}}}), "Upload failed")
+	LogYouTubeError(&YouTubeError{Type: ErrorTypeNetwork, Message: "timeout"}, "Upload failed")
+
+	entries := decodeLogLines(t, buf)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "uploadLimitExceeded", entries[0]["reason"], "operators need to know which quota to raise")
+	assert.Equal(t, string(ErrorTypeRateLimit), entries[0]["error_type"])
+	assert.NotContains(t, entries[1], "reason")
+}
+
+func TestLogForVideo(t *testing.T) {
+	buf := captureLogs(t)
+
+	LogForVideo(&storage.Video{Name: "intro", Category: "kubernetes"}).Info("processing")
+	LogForVideo(nil).Info("no video")
+
+	entries := decodeLogLines(t, buf)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "intro", entries[0]["video_name"])
+	assert.Equal(t, "kubernetes", entries[0]["category"])
+	assert.Equal(t, "youtube", entries[0]["component"])
+	assert.NotContains(t, entries[1], "video_name")
+	assert.Equal(t, "youtube", entries[1]["component"])
+}
+
+func TestValidateAndSetLanguage_LogsVideoIdentity(t *testing.T) {
+	buf := captureLogs(t)
+	YouTubeMetrics.Reset()
+
+	video := &storage.Video{Name: "intro", Category: "kubernetes", Language: "invalid"}
+	require.NoError(t, ValidateAndSetLanguage(&youtube.Video{}, video, "en"))
+
+	entries := decodeLogLines(t, buf)
+	require.NotEmpty(t, entries)
+	for _, entry := range entries {
+		assert.Equal(t, "intro", entry["video_name"], "entry %v", entry["msg"])
+		assert.Equal(t, "kubernetes", entry["category"], "entry %v", entry["msg"])
+	}
+}
+
+func TestSetLogLevelFromString(t *testing.T) {
+	captureLogs(t)
+
+	tests := []struct {
+		name        string
+		level       string
+		expected    logrus.Level
+		expectError bool
+	}{
+		{name: "debug", level: "debug", expected: logrus.DebugLevel},
+		{name: "upper case", level: "WARN", expected: logrus.WarnLevel},
+		{name: "padded", level: " error ", expected: logrus.ErrorLevel},
+		{name: "invalid", level: "verbose", expected: logrus.InfoLevel, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetLogLevel(logrus.InfoLevel)
+			err := SetLogLevelFromString(tt.level)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.level)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, youtubeLog.GetLevel())
+		})
+	}
+}
@@ -1,50 +1,185 @@
 package publishing
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"sync/atomic"
 )
 
 // Metrics tracks various YouTube operation statistics.
 type Metrics struct {
-	LanguageSetSuccess   int64 // Counter for successful language settings
-	LanguageSetFailure   int64 // Counter for failed language settings
-	UploadSuccess        int64 // Counter for successful uploads
-	UploadFailure        int64 // Counter for failed uploads
-	LanguageValidation   int64 // Counter for language validations
-	LanguageFallback     int64 // Counter for language fallbacks to default
+	LanguageSetSuccess    int64 // Counter for successful language settings
+	LanguageSetFailure    int64 // Counter for failed language settings
+	UploadSuccess         int64 // Counter for successful uploads
+	UploadFailure         int64 // Counter for failed uploads
+	LanguageValidation    int64 // Counter for language validations
+	LanguageFallback      int64 // Counter for language fallbacks to default
+	CaptionUploadSuccess  int64 // Counter for successful caption uploads
+	CaptionUploadFailure  int64 // Counter for failed caption uploads
+	CategoryFallback      int64 // Counter for category fallbacks to default
+	ThumbnailSetSuccess   int64 // Counter for successful thumbnail uploads
+	ThumbnailSetFailure   int64 // Counter for failed thumbnail uploads
+	BlueSkyPostSuccess    int64 // Counter for successful BlueSky posts
+	BlueSkyPostFailure    int64 // Counter for failed BlueSky posts
+	MetadataUpdateSuccess int64 // Counter for successful video metadata updates
+	MetadataUpdateFailure int64 // Counter for failed video metadata updates
+	BytesUploaded         int64 // Counter for uploaded bytes
+
+	snapshotMu sync.RWMutex // Shared by writers, exclusive for Snapshot so it sees a consistent state
+
+	languageMu          sync.Mutex       // Guards the per-language maps below
+	fallbacksByLanguage map[string]int64 // Fallbacks keyed by the originally requested language code
+	successesByLanguage map[string]int64 // Successful language settings keyed by the requested language code
+
+	uploadWindow atomic.Pointer[rollingWindow] // Recent upload outcomes, nil unless EnableRollingWindow was called
 }
 
 // YouTubeMetrics is the global metrics instance.
 var YouTubeMetrics = &Metrics{}
 
+// inc atomically increments a counter while holding the snapshot lock for reading.
+func (m *Metrics) inc(counter *int64) {
+	m.add(counter, 1)
+}
+
+// add adds n to counter, see inc.
+func (m *Metrics) add(counter *int64, n int64) {
+	m.snapshotMu.RLock()
+	atomic.AddInt64(counter, n)
+	m.snapshotMu.RUnlock()
+}
+
 // IncLanguageSetSuccess increments the successful language setting counter.
 func (m *Metrics) IncLanguageSetSuccess() {
-	atomic.AddInt64(&m.LanguageSetSuccess, 1)
+	m.inc(&m.LanguageSetSuccess)
 }
 
 // IncLanguageSetFailure increments the failed language setting counter.
 func (m *Metrics) IncLanguageSetFailure() {
-	atomic.AddInt64(&m.LanguageSetFailure, 1)
+	m.inc(&m.LanguageSetFailure)
 }
 
 // IncUploadSuccess increments the successful upload counter.
 func (m *Metrics) IncUploadSuccess() {
-	atomic.AddInt64(&m.UploadSuccess, 1)
+	m.inc(&m.UploadSuccess)
+	m.recordUpload(true)
 }
 
 // IncUploadFailure increments the failed upload counter.
 func (m *Metrics) IncUploadFailure() {
-	atomic.AddInt64(&m.UploadFailure, 1)
+	m.inc(&m.UploadFailure)
+	m.recordUpload(false)
 }
 
 // IncLanguageValidation increments the language validation counter.
 func (m *Metrics) IncLanguageValidation() {
-	atomic.AddInt64(&m.LanguageValidation, 1)
+	m.inc(&m.LanguageValidation)
 }
 
 // IncLanguageFallback increments the language fallback counter.
 func (m *Metrics) IncLanguageFallback() {
-	atomic.AddInt64(&m.LanguageFallback, 1)
+	m.inc(&m.LanguageFallback)
+}
+
+// IncCaptionUploadSuccess increments the successful caption upload counter.
+func (m *Metrics) IncCaptionUploadSuccess() {
+	m.inc(&m.CaptionUploadSuccess)
+}
+
+// IncCaptionUploadFailure increments the failed caption upload counter.
+func (m *Metrics) IncCaptionUploadFailure() {
+	m.inc(&m.CaptionUploadFailure)
+}
+
+// IncCategoryFallback increments the category fallback counter.
+func (m *Metrics) IncCategoryFallback() {
+	m.inc(&m.CategoryFallback)
+}
+
+// IncThumbnailSetSuccess increments the successful thumbnail upload counter.
+func (m *Metrics) IncThumbnailSetSuccess() {
+	m.inc(&m.ThumbnailSetSuccess)
+}
+
+// IncThumbnailSetFailure increments the failed thumbnail upload counter.
+func (m *Metrics) IncThumbnailSetFailure() {
+	m.inc(&m.ThumbnailSetFailure)
+}
+
+// IncBlueSkyPostSuccess increments the successful BlueSky posts counter.
+func (m *Metrics) IncBlueSkyPostSuccess() {
+	m.inc(&m.BlueSkyPostSuccess)
+}
+
+// IncBlueSkyPostFailure increments the failed BlueSky posts counter.
+func (m *Metrics) IncBlueSkyPostFailure() {
+	m.inc(&m.BlueSkyPostFailure)
+}
+
+// IncMetadataUpdateSuccess increments the successful video metadata updates counter.
+func (m *Metrics) IncMetadataUpdateSuccess() {
+	m.inc(&m.MetadataUpdateSuccess)
+}
+
+// IncMetadataUpdateFailure increments the failed video metadata updates counter.
+func (m *Metrics) IncMetadataUpdateFailure() {
+	m.inc(&m.MetadataUpdateFailure)
+}
+
+// AddBytesUploaded adds n to the uploaded bytes counter. Non-positive values are ignored.
+func (m *Metrics) AddBytesUploaded(n int64) {
+	if n > 0 {
+		m.add(&m.BytesUploaded, n)
+	}
+}
+
+// RecordLanguageFallback records a fallback for the originally requested language code.
+func (m *Metrics) RecordLanguageFallback(language string) {
+	m.snapshotMu.RLock()
+	defer m.snapshotMu.RUnlock()
+	m.languageMu.Lock()
+	defer m.languageMu.Unlock()
+	if m.fallbacksByLanguage == nil {
+		m.fallbacksByLanguage = make(map[string]int64)
+	}
+	m.fallbacksByLanguage[language]++
+}
+
+// RecordLanguageSuccess records a successful language setting for the requested language code.
+func (m *Metrics) RecordLanguageSuccess(language string) {
+	m.snapshotMu.RLock()
+	defer m.snapshotMu.RUnlock()
+	m.languageMu.Lock()
+	defer m.languageMu.Unlock()
+	if m.successesByLanguage == nil {
+		m.successesByLanguage = make(map[string]int64)
+	}
+	m.successesByLanguage[language]++
+}
+
+// GetFallbacksByLanguage returns a copy of the fallback counts keyed by requested language code.
+func (m *Metrics) GetFallbacksByLanguage() map[string]int64 {
+	m.languageMu.Lock()
+	defer m.languageMu.Unlock()
+	return copyLanguageCounts(m.fallbacksByLanguage)
+}
+
+// GetSuccessesByLanguage returns a copy of the success counts keyed by requested language code.
+func (m *Metrics) GetSuccessesByLanguage() map[string]int64 {
+	m.languageMu.Lock()
+	defer m.languageMu.Unlock()
+	return copyLanguageCounts(m.successesByLanguage)
+}
+
+func copyLanguageCounts(counts map[string]int64) map[string]int64 {
+	result := make(map[string]int64, len(counts))
+	for language, count := range counts {
+		result[language] = count
+	}
+	return result
 }
 
 // GetLanguageSetSuccess returns the current value of successful language settings.
@@ -77,6 +212,56 @@ func (m *Metrics) GetLanguageFallback() int64 {
 	return atomic.LoadInt64(&m.LanguageFallback)
 }
 
+// GetCaptionUploadSuccess returns the current value of successful caption uploads.
+func (m *Metrics) GetCaptionUploadSuccess() int64 {
+	return atomic.LoadInt64(&m.CaptionUploadSuccess)
+}
+
+// GetCaptionUploadFailure returns the current value of failed caption uploads.
+func (m *Metrics) GetCaptionUploadFailure() int64 {
+	return atomic.LoadInt64(&m.CaptionUploadFailure)
+}
+
+// GetCategoryFallback returns the current value of category fallbacks.
+func (m *Metrics) GetCategoryFallback() int64 {
+	return atomic.LoadInt64(&m.CategoryFallback)
+}
+
+// GetThumbnailSetSuccess returns the current value of successful thumbnail uploads.
+func (m *Metrics) GetThumbnailSetSuccess() int64 {
+	return atomic.LoadInt64(&m.ThumbnailSetSuccess)
+}
+
+// GetThumbnailSetFailure returns the current value of failed thumbnail uploads.
+func (m *Metrics) GetThumbnailSetFailure() int64 {
+	return atomic.LoadInt64(&m.ThumbnailSetFailure)
+}
+
+// GetBlueSkyPostSuccess returns the current value of successful BlueSky posts.
+func (m *Metrics) GetBlueSkyPostSuccess() int64 {
+	return atomic.LoadInt64(&m.BlueSkyPostSuccess)
+}
+
+// GetBlueSkyPostFailure returns the current value of failed BlueSky posts.
+func (m *Metrics) GetBlueSkyPostFailure() int64 {
+	return atomic.LoadInt64(&m.BlueSkyPostFailure)
+}
+
+// GetMetadataUpdateSuccess returns the current value of successful video metadata updates.
+func (m *Metrics) GetMetadataUpdateSuccess() int64 {
+	return atomic.LoadInt64(&m.MetadataUpdateSuccess)
+}
+
+// GetMetadataUpdateFailure returns the current value of failed video metadata updates.
+func (m *Metrics) GetMetadataUpdateFailure() int64 {
+	return atomic.LoadInt64(&m.MetadataUpdateFailure)
+}
+
+// GetBytesUploaded returns the current value of uploaded bytes.
+func (m *Metrics) GetBytesUploaded() int64 {
+	return atomic.LoadInt64(&m.BytesUploaded)
+}
+
 // GetLanguageSetTotal returns the total number of language setting attempts.
 func (m *Metrics) GetLanguageSetTotal() int64 {
 	return m.GetLanguageSetSuccess() + m.GetLanguageSetFailure()
@@ -107,10 +292,146 @@ func (m *Metrics) GetUploadSuccessRate() float64 {
 
 // Reset resets all metrics to zero.
 func (m *Metrics) Reset() {
+	m.snapshotMu.Lock()
+	defer m.snapshotMu.Unlock()
+
 	atomic.StoreInt64(&m.LanguageSetSuccess, 0)
 	atomic.StoreInt64(&m.LanguageSetFailure, 0)
 	atomic.StoreInt64(&m.UploadSuccess, 0)
 	atomic.StoreInt64(&m.UploadFailure, 0)
 	atomic.StoreInt64(&m.LanguageValidation, 0)
 	atomic.StoreInt64(&m.LanguageFallback, 0)
+	atomic.StoreInt64(&m.CaptionUploadSuccess, 0)
+	atomic.StoreInt64(&m.CaptionUploadFailure, 0)
+	atomic.StoreInt64(&m.CategoryFallback, 0)
+	atomic.StoreInt64(&m.ThumbnailSetSuccess, 0)
+	atomic.StoreInt64(&m.ThumbnailSetFailure, 0)
+	atomic.StoreInt64(&m.BlueSkyPostSuccess, 0)
+	atomic.StoreInt64(&m.BlueSkyPostFailure, 0)
+	atomic.StoreInt64(&m.MetadataUpdateSuccess, 0)
+	atomic.StoreInt64(&m.MetadataUpdateFailure, 0)
+	atomic.StoreInt64(&m.BytesUploaded, 0)
+
+	m.languageMu.Lock()
+	m.fallbacksByLanguage = nil
+	m.successesByLanguage = nil
+	m.languageMu.Unlock()
+
+	if window := m.uploadWindow.Load(); window != nil {
+		window.reset()
+	}
+}
+
+// MetricsSnapshot is a point-in-time copy of Metrics holding plain values.
+type MetricsSnapshot struct {
+	LanguageSetSuccess     int64            `json:"languageSetSuccess"`
+	LanguageSetFailure     int64            `json:"languageSetFailure"`
+	UploadSuccess          int64            `json:"uploadSuccess"`
+	UploadFailure          int64            `json:"uploadFailure"`
+	LanguageValidation     int64            `json:"languageValidation"`
+	LanguageFallback       int64            `json:"languageFallback"`
+	CaptionUploadSuccess   int64            `json:"captionUploadSuccess"`
+	CaptionUploadFailure   int64            `json:"captionUploadFailure"`
+	CategoryFallback       int64            `json:"categoryFallback"`
+	ThumbnailSetSuccess    int64            `json:"thumbnailSetSuccess"`
+	ThumbnailSetFailure    int64            `json:"thumbnailSetFailure"`
+	BlueSkyPostSuccess     int64            `json:"blueSkyPostSuccess"`
+	BlueSkyPostFailure     int64            `json:"blueSkyPostFailure"`
+	MetadataUpdateSuccess  int64            `json:"metadataUpdateSuccess"`
+	MetadataUpdateFailure  int64            `json:"metadataUpdateFailure"`
+	BytesUploaded          int64            `json:"bytesUploaded"`
+	LanguageSetSuccessRate float64          `json:"languageSetSuccessRate"`
+	UploadSuccessRate      float64          `json:"uploadSuccessRate"`
+	FallbacksByLanguage    map[string]int64 `json:"fallbacksByLanguage"`
+	SuccessesByLanguage    map[string]int64 `json:"successesByLanguage"`
+}
+
+// Snapshot returns a consistent copy of all counters, including the computed success rates.
+// Writers are blocked for the duration of the copy so counters cannot drift relative to each other.
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	m.snapshotMu.Lock()
+	defer m.snapshotMu.Unlock()
+
+	snapshot := MetricsSnapshot{
+		LanguageSetSuccess:    atomic.LoadInt64(&m.LanguageSetSuccess),
+		LanguageSetFailure:    atomic.LoadInt64(&m.LanguageSetFailure),
+		UploadSuccess:         atomic.LoadInt64(&m.UploadSuccess),
+		UploadFailure:         atomic.LoadInt64(&m.UploadFailure),
+		LanguageValidation:    atomic.LoadInt64(&m.LanguageValidation),
+		LanguageFallback:      atomic.LoadInt64(&m.LanguageFallback),
+		CaptionUploadSuccess:  atomic.LoadInt64(&m.CaptionUploadSuccess),
+		CaptionUploadFailure:  atomic.LoadInt64(&m.CaptionUploadFailure),
+		CategoryFallback:      atomic.LoadInt64(&m.CategoryFallback),
+		ThumbnailSetSuccess:   atomic.LoadInt64(&m.ThumbnailSetSuccess),
+		ThumbnailSetFailure:   atomic.LoadInt64(&m.ThumbnailSetFailure),
+		BlueSkyPostSuccess:    atomic.LoadInt64(&m.BlueSkyPostSuccess),
+		BlueSkyPostFailure:    atomic.LoadInt64(&m.BlueSkyPostFailure),
+		MetadataUpdateSuccess: atomic.LoadInt64(&m.MetadataUpdateSuccess),
+		MetadataUpdateFailure: atomic.LoadInt64(&m.MetadataUpdateFailure),
+		BytesUploaded:         atomic.LoadInt64(&m.BytesUploaded),
+		FallbacksByLanguage:   m.GetFallbacksByLanguage(),
+		SuccessesByLanguage:   m.GetSuccessesByLanguage(),
+	}
+	snapshot.LanguageSetSuccessRate = successRate(snapshot.LanguageSetSuccess, snapshot.LanguageSetFailure)
+	snapshot.UploadSuccessRate = successRate(snapshot.UploadSuccess, snapshot.UploadFailure)
+	return snapshot
+}
+
+// MarshalJSON encodes the current metrics snapshot as JSON.
+func (m *Metrics) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Snapshot())
+}
+
+// successRate returns successes divided by all attempts, or 0.0 when there were no attempts.
+func successRate(success, failure int64) float64 {
+	total := success + failure
+	if total == 0 {
+		return 0.0
+	}
+	return float64(success) / float64(total)
+}
+
+// Report returns a human-readable summary of the metrics, one line per operation.
+func (m *Metrics) Report() string {
+	s := m.Snapshot()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Uploads: %s\n", outcomes(s.UploadSuccess, s.UploadFailure))
+	fmt.Fprintf(&b, "Bytes uploaded: %d\n", s.BytesUploaded)
+	fmt.Fprintf(&b, "Language settings: %s\n", outcomes(s.LanguageSetSuccess, s.LanguageSetFailure))
+	fmt.Fprintf(&b, "Language validations: %d, fallbacks: %d\n", s.LanguageValidation, s.LanguageFallback)
+	if len(s.FallbacksByLanguage) > 0 {
+		languages := make([]string, 0, len(s.FallbacksByLanguage))
+		for language := range s.FallbacksByLanguage {
+			languages = append(languages, language)
+		}
+		sort.Strings(languages)
+		for _, language := range languages {
+			fmt.Fprintf(&b, "  %q: %d\n", language, s.FallbacksByLanguage[language])
+		}
+	}
+	fmt.Fprintf(&b, "Category fallbacks: %d\n", s.CategoryFallback)
+	fmt.Fprintf(&b, "Caption uploads: %s\n", outcomes(s.CaptionUploadSuccess, s.CaptionUploadFailure))
+	fmt.Fprintf(&b, "Thumbnail uploads: %s\n", outcomes(s.ThumbnailSetSuccess, s.ThumbnailSetFailure))
+	fmt.Fprintf(&b, "BlueSky posts: %s\n", outcomes(s.BlueSkyPostSuccess, s.BlueSkyPostFailure))
+	fmt.Fprintf(&b, "Metadata updates: %s\n", outcomes(s.MetadataUpdateSuccess, s.MetadataUpdateFailure))
+	return b.String()
+}
+
+// outcomes formats success and failure counts, with the success rate when there were attempts.
+func outcomes(success, failure int64) string {
+	if success+failure == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d succeeded, %d failed (%.1f%% success)", success, failure, successRate(success, failure)*100)
+}
+
+// IsHealthy reports whether the upload success rate is at least minUploadRate (0.0 to 1.0).
+// Without any upload attempts there is nothing to judge, so the metrics are healthy.
+func (m *Metrics) IsHealthy(minUploadRate float64) bool {
+	s := m.Snapshot()
+	if s.UploadSuccess+s.UploadFailure == 0 {
+		return true
+	}
+	return s.UploadSuccessRate >= minUploadRate
 }
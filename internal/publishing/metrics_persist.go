@@ -0,0 +1,84 @@
+package publishing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+)
+
+// SaveTo writes a snapshot of the counters to path as JSON, so that LoadFrom can resume them in
+// a later process. The file is replaced atomically, leaving the previous one intact if writing
+// fails.
+func (m *Metrics) SaveTo(path string) error {
+	data, err := json.MarshalIndent(m.Snapshot(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode metrics: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to save metrics to %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save metrics to %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save metrics to %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save metrics to %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadFrom replaces the counters with the ones saved to path by SaveTo. A missing file is not an
+// error: the counters are left as they are, which for a fresh process means starting from zero.
+// The rolling upload window is not persisted.
+func (m *Metrics) LoadFrom(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read metrics from %s: %w", path, err)
+	}
+	var snapshot MetricsSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("failed to decode metrics from %s: %w", path, err)
+	}
+	m.restore(snapshot)
+	return nil
+}
+
+// restore sets every counter to its value in snapshot.
+func (m *Metrics) restore(snapshot MetricsSnapshot) {
+	m.snapshotMu.Lock()
+	defer m.snapshotMu.Unlock()
+
+	atomic.StoreInt64(&m.LanguageSetSuccess, snapshot.LanguageSetSuccess)
+	atomic.StoreInt64(&m.LanguageSetFailure, snapshot.LanguageSetFailure)
+	atomic.StoreInt64(&m.UploadSuccess, snapshot.UploadSuccess)
+	atomic.StoreInt64(&m.UploadFailure, snapshot.UploadFailure)
+	atomic.StoreInt64(&m.LanguageValidation, snapshot.LanguageValidation)
+	atomic.StoreInt64(&m.LanguageFallback, snapshot.LanguageFallback)
+	atomic.StoreInt64(&m.CaptionUploadSuccess, snapshot.CaptionUploadSuccess)
+	atomic.StoreInt64(&m.CaptionUploadFailure, snapshot.CaptionUploadFailure)
+	atomic.StoreInt64(&m.CategoryFallback, snapshot.CategoryFallback)
+	atomic.StoreInt64(&m.ThumbnailSetSuccess, snapshot.ThumbnailSetSuccess)
+	atomic.StoreInt64(&m.ThumbnailSetFailure, snapshot.ThumbnailSetFailure)
+	atomic.StoreInt64(&m.BlueSkyPostSuccess, snapshot.BlueSkyPostSuccess)
+	atomic.StoreInt64(&m.BlueSkyPostFailure, snapshot.BlueSkyPostFailure)
+	atomic.StoreInt64(&m.MetadataUpdateSuccess, snapshot.MetadataUpdateSuccess)
+	atomic.StoreInt64(&m.MetadataUpdateFailure, snapshot.MetadataUpdateFailure)
+	atomic.StoreInt64(&m.BytesUploaded, snapshot.BytesUploaded)
+
+	m.languageMu.Lock()
+	m.fallbacksByLanguage = copyLanguageCounts(snapshot.FallbacksByLanguage)
+	m.successesByLanguage = copyLanguageCounts(snapshot.SuccessesByLanguage)
+	m.languageMu.Unlock()
+}
@@ -0,0 +1,62 @@
+package publishing
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics_SaveToLoadFrom(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+
+	saved := &Metrics{}
+	// Give every counter a distinct value so a counter missing from restore is caught
+	counters := reflect.ValueOf(saved).Elem()
+	for i := 0; i < counters.NumField(); i++ {
+		if field := counters.Field(i); field.Kind() == reflect.Int64 && field.CanSet() {
+			field.SetInt(int64(i + 1))
+		}
+	}
+	saved.RecordLanguageFallback("xx")
+	saved.RecordLanguageFallback("xx")
+	saved.RecordLanguageSuccess("de")
+	require.NoError(t, saved.SaveTo(path))
+
+	loaded := &Metrics{}
+	require.NoError(t, loaded.LoadFrom(path))
+	assert.Equal(t, saved.Snapshot(), loaded.Snapshot())
+	assert.Equal(t, map[string]int64{"xx": 2}, loaded.GetFallbacksByLanguage())
+
+	// Counting resumes from the restored values
+	loaded.IncUploadSuccess()
+	assert.Equal(t, saved.GetUploadSuccess()+1, loaded.GetUploadSuccess())
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary files must be left behind")
+}
+
+func TestMetrics_LoadFromMissingFile(t *testing.T) {
+	metrics := &Metrics{}
+	require.NoError(t, metrics.LoadFrom(filepath.Join(t.TempDir(), "missing.json")))
+	assert.Equal(t, (&Metrics{}).Snapshot(), metrics.Snapshot())
+}
+
+func TestMetrics_LoadFromInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0644))
+
+	metrics := &Metrics{}
+	metrics.IncUploadSuccess()
+	assert.Error(t, metrics.LoadFrom(path))
+	assert.Equal(t, int64(1), metrics.GetUploadSuccess(), "a failed load must leave the counters untouched")
+}
+
+func TestMetrics_SaveToMissingDirectory(t *testing.T) {
+	metrics := &Metrics{}
+	assert.Error(t, metrics.SaveTo(filepath.Join(t.TempDir(), "missing", "metrics.json")))
+}
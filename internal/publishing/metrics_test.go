@@ -1,6 +1,7 @@
 package publishing
 
 import (
+	"encoding/json"
 	"sync"
 	"testing"
 
@@ -136,6 +137,19 @@ func TestMetrics_Reset(t *testing.T) {
 	assert.Equal(t, int64(0), YouTubeMetrics.GetLanguageFallback())
 }
 
+func TestMetrics_BytesUploaded(t *testing.T) {
+	m := &Metrics{}
+	m.AddBytesUploaded(100)
+	m.AddBytesUploaded(250)
+	m.AddBytesUploaded(0)
+	m.AddBytesUploaded(-10)
+	assert.Equal(t, int64(350), m.GetBytesUploaded())
+	assert.Equal(t, int64(350), m.Snapshot().BytesUploaded)
+
+	m.Reset()
+	assert.Zero(t, m.GetBytesUploaded())
+}
+
 func TestMetrics_EdgeCases(t *testing.T) {
 	// Reset metrics to ensure clean state
 	YouTubeMetrics.Reset()
@@ -153,3 +167,205 @@ func TestMetrics_EdgeCases(t *testing.T) {
 
 	assert.Equal(t, 1.0, YouTubeMetrics.GetLanguageSetSuccessRate())
 }
+
+func TestMetrics_PerLanguage(t *testing.T) {
+	metrics := &Metrics{}
+
+	assert.Empty(t, metrics.GetFallbacksByLanguage())
+	assert.Empty(t, metrics.GetSuccessesByLanguage())
+
+	metrics.RecordLanguageFallback("xx")
+	metrics.RecordLanguageFallback("xx")
+	metrics.RecordLanguageFallback("klingon")
+	metrics.RecordLanguageSuccess("de")
+
+	assert.Equal(t, map[string]int64{"xx": 2, "klingon": 1}, metrics.GetFallbacksByLanguage())
+	assert.Equal(t, map[string]int64{"de": 1}, metrics.GetSuccessesByLanguage())
+
+	// Returned maps are copies and must not leak internal state
+	fallbacks := metrics.GetFallbacksByLanguage()
+	fallbacks["xx"] = 100
+	assert.Equal(t, int64(2), metrics.GetFallbacksByLanguage()["xx"])
+
+	metrics.Reset()
+	assert.Empty(t, metrics.GetFallbacksByLanguage())
+	assert.Empty(t, metrics.GetSuccessesByLanguage())
+}
+
+func TestMetrics_PerLanguageConcurrentAccess(t *testing.T) {
+	metrics := &Metrics{}
+
+	const numGoroutines = 100
+	const incrementsPerGoroutine = 10
+
+	var wg sync.WaitGroup
+	wg.Add(numGoroutines)
+
+	for i := 0; i < numGoroutines; i++ {
+		go func(i int) {
+			defer wg.Done()
+			language := "es"
+			if i%2 == 0 {
+				language = "xx"
+			}
+			for j := 0; j < incrementsPerGoroutine; j++ {
+				metrics.RecordLanguageFallback(language)
+				metrics.RecordLanguageSuccess(language)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	expectedCount := int64(numGoroutines / 2 * incrementsPerGoroutine)
+	assert.Equal(t, map[string]int64{"es": expectedCount, "xx": expectedCount}, metrics.GetFallbacksByLanguage())
+	assert.Equal(t, map[string]int64{"es": expectedCount, "xx": expectedCount}, metrics.GetSuccessesByLanguage())
+}
+
+func TestMetrics_Snapshot(t *testing.T) {
+	metrics := &Metrics{}
+	metrics.IncLanguageSetSuccess()
+	metrics.IncLanguageSetFailure()
+	metrics.IncUploadSuccess()
+	metrics.IncUploadSuccess()
+	metrics.IncUploadSuccess()
+	metrics.IncUploadFailure()
+	metrics.RecordLanguageFallback("xx")
+
+	snapshot := metrics.Snapshot()
+
+	assert.Equal(t, int64(1), snapshot.LanguageSetSuccess)
+	assert.Equal(t, int64(1), snapshot.LanguageSetFailure)
+	assert.Equal(t, int64(3), snapshot.UploadSuccess)
+	assert.Equal(t, int64(1), snapshot.UploadFailure)
+	assert.Equal(t, 0.5, snapshot.LanguageSetSuccessRate)
+	assert.Equal(t, 0.75, snapshot.UploadSuccessRate)
+	assert.Equal(t, map[string]int64{"xx": 1}, snapshot.FallbacksByLanguage)
+
+	// The snapshot is a copy and does not follow later changes
+	metrics.IncUploadFailure()
+	metrics.RecordLanguageFallback("xx")
+	assert.Equal(t, int64(1), snapshot.UploadFailure)
+	assert.Equal(t, int64(1), snapshot.FallbacksByLanguage["xx"])
+}
+
+func TestMetrics_SnapshotConsistentDuringWrites(t *testing.T) {
+	metrics := &Metrics{}
+
+	const numGoroutines = 50
+	const incrementsPerGoroutine = 200
+
+	var wg sync.WaitGroup
+	wg.Add(numGoroutines)
+	for i := 0; i < numGoroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < incrementsPerGoroutine; j++ {
+				metrics.IncUploadSuccess()
+				metrics.IncUploadFailure()
+			}
+		}()
+	}
+
+	for i := 0; i < 100; i++ {
+		snapshot := metrics.Snapshot()
+		assert.Equal(t, successRate(snapshot.UploadSuccess, snapshot.UploadFailure), snapshot.UploadSuccessRate)
+		assert.LessOrEqual(t, snapshot.UploadSuccess, int64(numGoroutines*incrementsPerGoroutine))
+	}
+
+	wg.Wait()
+
+	final := metrics.Snapshot()
+	assert.Equal(t, int64(numGoroutines*incrementsPerGoroutine), final.UploadSuccess)
+	assert.Equal(t, int64(numGoroutines*incrementsPerGoroutine), final.UploadFailure)
+	assert.Equal(t, 0.5, final.UploadSuccessRate)
+}
+
+func TestMetrics_MarshalJSON(t *testing.T) {
+	metrics := &Metrics{}
+	metrics.IncUploadSuccess()
+	metrics.IncLanguageFallback()
+	metrics.RecordLanguageFallback("xx")
+
+	data, err := json.Marshal(metrics)
+	assert.NoError(t, err)
+
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+
+	expectedKeys := []string{
+		"languageSetSuccess",
+		"languageSetFailure",
+		"uploadSuccess",
+		"uploadFailure",
+		"languageValidation",
+		"languageFallback",
+		"captionUploadSuccess",
+		"captionUploadFailure",
+		"categoryFallback",
+		"thumbnailSetSuccess",
+		"thumbnailSetFailure",
+		"blueSkyPostSuccess",
+		"blueSkyPostFailure",
+		"metadataUpdateSuccess",
+		"metadataUpdateFailure",
+		"bytesUploaded",
+		"languageSetSuccessRate",
+		"uploadSuccessRate",
+		"fallbacksByLanguage",
+		"successesByLanguage",
+	}
+	assert.Len(t, decoded, len(expectedKeys))
+	for _, key := range expectedKeys {
+		assert.Contains(t, decoded, key)
+	}
+	assert.Equal(t, float64(1), decoded["uploadSuccess"])
+	assert.Equal(t, float64(1), decoded["uploadSuccessRate"])
+	assert.Equal(t, map[string]interface{}{"xx": float64(1)}, decoded["fallbacksByLanguage"])
+}
+
+func TestMetrics_IsHealthy(t *testing.T) {
+	tests := []struct {
+		name     string
+		success  int
+		failure  int
+		expected bool
+	}{
+		{name: "Zero attempts", success: 0, failure: 0, expected: true},
+		{name: "Above threshold", success: 9, failure: 1, expected: true},
+		{name: "At threshold", success: 8, failure: 2, expected: true},
+		{name: "Below threshold", success: 3, failure: 2, expected: false},
+		{name: "Only failures", success: 0, failure: 1, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Metrics{}
+			for range tt.success {
+				m.IncUploadSuccess()
+			}
+			for range tt.failure {
+				m.IncUploadFailure()
+			}
+			assert.Equal(t, tt.expected, m.IsHealthy(0.8))
+		})
+	}
+}
+
+func TestMetrics_Report(t *testing.T) {
+	m := &Metrics{}
+	m.IncUploadSuccess()
+	m.IncUploadSuccess()
+	m.IncUploadSuccess()
+	m.IncUploadFailure()
+	m.IncLanguageFallback()
+	m.RecordLanguageFallback("xx")
+	m.AddBytesUploaded(1024)
+
+	report := m.Report()
+	assert.Contains(t, report, "Uploads: 3 succeeded, 1 failed (75.0% success)\n")
+	assert.Contains(t, report, "Bytes uploaded: 1024\n")
+	assert.Contains(t, report, "Language validations: 0, fallbacks: 1\n")
+	assert.Contains(t, report, "  \"xx\": 1\n")
+	assert.Contains(t, report, "BlueSky posts: none\n")
+}
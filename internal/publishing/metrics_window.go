@@ -0,0 +1,96 @@
+package publishing
+
+import (
+	"sync"
+	"time"
+)
+
+// windowBucketWidth is the granularity of the rolling upload window.
+const windowBucketWidth = time.Minute
+
+// rollingWindow counts upload outcomes in a ring of fixed-width time buckets. A bucket is
+// reused once its slot falls out of the window, so old outcomes age out without a sweeper.
+type rollingWindow struct {
+	mu      sync.Mutex
+	buckets []windowBucket
+}
+
+// windowBucket holds the outcomes recorded during one windowBucketWidth slot.
+type windowBucket struct {
+	slot    int64 // Time slot the counts belong to, in windowBucketWidth units since the epoch
+	success int64
+	failure int64
+}
+
+func newRollingWindow(window time.Duration) *rollingWindow {
+	return &rollingWindow{buckets: make([]windowBucket, max(1, bucketsIn(window)))}
+}
+
+// bucketsIn returns how many buckets are needed to cover d.
+func bucketsIn(d time.Duration) int {
+	return int((d + windowBucketWidth - 1) / windowBucketWidth)
+}
+
+func (w *rollingWindow) record(now time.Time, success bool) {
+	slot := now.UnixNano() / int64(windowBucketWidth)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	bucket := &w.buckets[slot%int64(len(w.buckets))]
+	if bucket.slot != slot {
+		*bucket = windowBucket{slot: slot}
+	}
+	if success {
+		bucket.success++
+	} else {
+		bucket.failure++
+	}
+}
+
+// sum adds up the buckets within d of now, the current bucket included. Buckets older than
+// the window may not have been reused yet, so d is capped at the window size.
+func (w *rollingWindow) sum(now time.Time, d time.Duration) (success, failure int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	current := now.UnixNano() / int64(windowBucketWidth)
+	oldest := current - int64(min(bucketsIn(d), len(w.buckets))) + 1
+	for _, bucket := range w.buckets {
+		if bucket.slot >= oldest && bucket.slot <= current {
+			success += bucket.success
+			failure += bucket.failure
+		}
+	}
+	return success, failure
+}
+
+func (w *rollingWindow) reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	clear(w.buckets)
+}
+
+// EnableRollingWindow makes m additionally keep the upload outcomes of the last window, in
+// one-minute buckets, for GetUploadSuccessRateWindow. The lifetime counters are unaffected,
+// and calling it again starts a new, empty window.
+func (m *Metrics) EnableRollingWindow(window time.Duration) {
+	m.uploadWindow.Store(newRollingWindow(window))
+}
+
+// recordUpload adds an upload outcome to the rolling window, if enabled.
+func (m *Metrics) recordUpload(success bool) {
+	if window := m.uploadWindow.Load(); window != nil {
+		window.record(clock.Now(), success)
+	}
+}
+
+// GetUploadSuccessRateWindow returns the upload success rate (0.0 to 1.0) over the last d,
+// rounded up to whole minutes and capped at the window passed to EnableRollingWindow. It
+// returns 0.0 when the rolling window isn't enabled or there were no uploads within d.
+func (m *Metrics) GetUploadSuccessRateWindow(d time.Duration) float64 {
+	window := m.uploadWindow.Load()
+	if window == nil {
+		return 0.0
+	}
+	return successRate(window.sum(clock.Now(), d))
+}
@@ -0,0 +1,55 @@
+package publishing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics_UploadSuccessRateWindow(t *testing.T) {
+	fake := useFakeClock(t)
+	m := &Metrics{}
+	m.EnableRollingWindow(10 * time.Minute)
+
+	m.IncUploadFailure()
+	m.IncUploadFailure()
+	fake.Sleep(5 * time.Minute)
+	m.IncUploadSuccess()
+	m.IncUploadFailure()
+
+	assert.Equal(t, 0.25, m.GetUploadSuccessRateWindow(10*time.Minute))
+	assert.Equal(t, 0.5, m.GetUploadSuccessRateWindow(time.Minute), "a shorter window only sees the recent bucket")
+
+	fake.Sleep(6 * time.Minute)
+	m.IncUploadSuccess()
+	m.IncUploadSuccess()
+
+	assert.Equal(t, 0.75, m.GetUploadSuccessRateWindow(10*time.Minute), "the first failures have aged out")
+	assert.Equal(t, 0.75, m.GetUploadSuccessRateWindow(time.Hour), "the window is capped at its configured size")
+	assert.Equal(t, 1.0, m.GetUploadSuccessRateWindow(time.Minute))
+	assert.Equal(t, 0.5, m.GetUploadSuccessRate(), "lifetime counters are unaffected")
+
+	fake.Sleep(time.Hour)
+	assert.Equal(t, 0.0, m.GetUploadSuccessRateWindow(10*time.Minute), "no uploads in the window")
+	assert.Equal(t, int64(6), m.GetUploadTotal())
+}
+
+func TestMetrics_UploadSuccessRateWindowDisabled(t *testing.T) {
+	m := &Metrics{}
+	m.IncUploadSuccess()
+
+	assert.Equal(t, 0.0, m.GetUploadSuccessRateWindow(time.Minute))
+}
+
+func TestMetrics_ResetClearsWindow(t *testing.T) {
+	useFakeClock(t)
+	m := &Metrics{}
+	m.EnableRollingWindow(time.Minute)
+	m.IncUploadSuccess()
+
+	m.Reset()
+	m.IncUploadFailure()
+
+	assert.Equal(t, 0.0, m.GetUploadSuccessRateWindow(time.Minute))
+}
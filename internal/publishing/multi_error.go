@@ -0,0 +1,55 @@
+package publishing
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MultiError aggregates the errors of an operation that works on several items, such as a
+// batch of uploads, so callers can inspect what went wrong by category.
+type MultiError struct {
+	Errors []*YouTubeError
+}
+
+// Error implements the error interface, listing every aggregated error.
+func (e *MultiError) Error() string {
+	switch len(e.Errors) {
+	case 0:
+		return "no errors"
+	case 1:
+		return e.Errors[0].Error()
+	}
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// Unwrap exposes the aggregated errors to errors.Is and errors.As.
+func (e *MultiError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// HasRetryable reports whether any of the aggregated errors is retryable.
+func (e *MultiError) HasRetryable() bool {
+	for _, err := range e.Errors {
+		if err.Retryable {
+			return true
+		}
+	}
+	return false
+}
+
+// ByType counts the aggregated errors per category.
+func (e *MultiError) ByType() map[ErrorType]int {
+	counts := make(map[ErrorType]int)
+	for _, err := range e.Errors {
+		counts[err.Type]++
+	}
+	return counts
+}
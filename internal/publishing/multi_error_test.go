@@ -0,0 +1,55 @@
+package publishing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiError(t *testing.T) {
+	authErr := &YouTubeError{Type: ErrorTypeAuth, Message: "token expired"}
+	multi := &MultiError{Errors: []*YouTubeError{
+		{Type: ErrorTypeServer, Message: "backend unavailable", Retryable: true},
+		authErr,
+		{Type: ErrorTypeServer, Message: "internal error", Retryable: true},
+		{Type: ErrorTypeInvalid, Message: "invalid title"},
+	}}
+
+	assert.Equal(t, map[ErrorType]int{
+		ErrorTypeServer:  2,
+		ErrorTypeAuth:    1,
+		ErrorTypeInvalid: 1,
+	}, multi.ByType())
+	assert.True(t, multi.HasRetryable())
+	assert.Contains(t, multi.Error(), "4 errors occurred")
+	assert.Contains(t, multi.Error(), "invalid title")
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, multi, &yErr)
+	assert.Equal(t, ErrorTypeServer, yErr.Type)
+	assert.True(t, errors.Is(multi, authErr))
+}
+
+func TestMultiError_NotRetryable(t *testing.T) {
+	multi := &MultiError{Errors: []*YouTubeError{
+		{Type: ErrorTypeAuth, Message: "token expired"},
+		{Type: ErrorTypeInvalid, Message: "invalid title"},
+	}}
+
+	assert.False(t, multi.HasRetryable())
+	assert.False(t, (&MultiError{}).HasRetryable())
+	assert.Empty(t, (&MultiError{}).ByType())
+}
+
+func TestBatchResult_Err(t *testing.T) {
+	assert.NoError(t, BatchResult{Outcomes: []BatchOutcome{{VideoID: "id"}, {Skipped: true}}}.Err())
+
+	serverErr := &YouTubeError{Type: ErrorTypeServer, Retryable: true}
+	err := BatchResult{Outcomes: []BatchOutcome{{VideoID: "id"}, {Err: serverErr}}}.Err()
+
+	var multi *MultiError
+	require.ErrorAs(t, err, &multi)
+	assert.Equal(t, []*YouTubeError{serverErr}, multi.Errors)
+}
@@ -0,0 +1,41 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// RegisterOTel exposes the core counters of m as OpenTelemetry observable counters on meter,
+// as an alternative to the Prometheus collector. Values are read from the atomic counters
+// whenever the meter collects, so nothing is recorded unless this is called.
+func (m *Metrics) RegisterOTel(meter metric.Meter) error {
+	counters := []struct {
+		name        string
+		description string
+		value       *int64
+	}{
+		{"youtube.language_set.success", "Number of successful language settings.", &m.LanguageSetSuccess},
+		{"youtube.language_set.failure", "Number of failed language settings.", &m.LanguageSetFailure},
+		{"youtube.upload.success", "Number of successful video uploads.", &m.UploadSuccess},
+		{"youtube.upload.failure", "Number of failed video uploads.", &m.UploadFailure},
+		{"youtube.language.validation", "Number of language validations.", &m.LanguageValidation},
+		{"youtube.language.fallback", "Number of language fallbacks to the default language.", &m.LanguageFallback},
+	}
+
+	for _, counter := range counters {
+		value := counter.value
+		_, err := meter.Int64ObservableCounter(counter.name,
+			metric.WithDescription(counter.description),
+			metric.WithInt64Callback(func(_ context.Context, observer metric.Int64Observer) error {
+				observer.Observe(atomic.LoadInt64(value))
+				return nil
+			}))
+		if err != nil {
+			return fmt.Errorf("failed to register OpenTelemetry counter %s: %w", counter.name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package publishing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// collectOTel reads the current values of the int64 sums registered on the reader, by name.
+func collectOTel(t *testing.T, reader sdkmetric.Reader) map[string]int64 {
+	t.Helper()
+	var data metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &data))
+
+	values := make(map[string]int64)
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok, "%s should be an int64 sum", m.Name)
+			require.Len(t, sum.DataPoints, 1)
+			assert.True(t, sum.IsMonotonic)
+			values[m.Name] = sum.DataPoints[0].Value
+		}
+	}
+	return values
+}
+
+func TestMetrics_RegisterOTel(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { provider.Shutdown(context.Background()) })
+
+	m := &Metrics{}
+	require.NoError(t, m.RegisterOTel(provider.Meter("youtube-automation")))
+
+	m.IncUploadSuccess()
+	m.IncUploadSuccess()
+	m.IncUploadFailure()
+	m.IncLanguageFallback()
+
+	assert.Equal(t, map[string]int64{
+		"youtube.language_set.success": 0,
+		"youtube.language_set.failure": 0,
+		"youtube.upload.success":       2,
+		"youtube.upload.failure":       1,
+		"youtube.language.validation":  0,
+		"youtube.language.fallback":    1,
+	}, collectOTel(t, reader))
+
+	m.IncLanguageSetSuccess()
+	assert.Equal(t, int64(1), collectOTel(t, reader)["youtube.language_set.success"], "values are read on every collection")
+}
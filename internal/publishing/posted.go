@@ -0,0 +1,62 @@
+package publishing
+
+import (
+	"context"
+
+	"devopstoolkit/youtube-automation/internal/notification"
+	"devopstoolkit/youtube-automation/internal/storage"
+)
+
+// PostToBlueSkyOnce runs PostToBlueSky unless the video is already marked as posted to BlueSky.
+// On success it sets BlueSkyPosted and saves the video to path, so re-running the workflow
+// doesn't post twice. The returned URI is empty when the post was skipped.
+func PostToBlueSkyOnce(ctx context.Context, store storage.Store, path string, cfg BlueSkyConfig, video *storage.Video) (postURI string, err error) {
+	if DryRun {
+		return PostToBlueSky(ctx, cfg, video)
+	}
+	ran, err := storage.RunOnce(store, path, video, blueSkyPosted, func() error {
+		postURI, err = PostToBlueSky(ctx, cfg, video)
+		return err
+	})
+	logSkippedPost(ran, err, "BlueSky post", video)
+	return postURI, err
+}
+
+// PostToSlackOnce runs PostToSlack unless the video is already marked as posted to Slack.
+// On success it sets SlackPosted and saves the video to path.
+func PostToSlackOnce(ctx context.Context, store storage.Store, path, webhookURL string, video *storage.Video) error {
+	if DryRun {
+		return PostToSlack(ctx, webhookURL, video)
+	}
+	ran, err := storage.RunOnce(store, path, video, slackPosted, func() error {
+		return PostToSlack(ctx, webhookURL, video)
+	})
+	logSkippedPost(ran, err, "Slack post", video)
+	return err
+}
+
+// NotifySponsorsOnce runs notification.NotifySponsors unless the sponsors were already notified.
+// NotifiedSponsors is only set when every recipient was reached, so a partial failure can be
+// retried; note that the retry emails all recipients again.
+func NotifySponsorsOnce(ctx context.Context, store storage.Store, path string, mailer notification.Mailer, video *storage.Video) (sent []string, err error) {
+	if video != nil && skipForDryRun("notify sponsors of video ID %s: %v", video.VideoId, video.Sponsorship.RedactedEmails()) {
+		return nil, nil
+	}
+	ran, err := storage.RunOnce(store, path, video, notifiedSponsors, func() error {
+		sent, err = notification.NotifySponsors(ctx, mailer, video)
+		return err
+	})
+	logSkippedPost(ran, err, "sponsor notification", video)
+	return sent, err
+}
+
+func blueSkyPosted(v *storage.Video) *bool    { return &v.BlueSkyPosted }
+func slackPosted(v *storage.Video) *bool      { return &v.SlackPosted }
+func notifiedSponsors(v *storage.Video) *bool { return &v.NotifiedSponsors }
+
+// logSkippedPost notes that an action was skipped because its flag was already set.
+func logSkippedPost(ran bool, err error, action string, video *storage.Video) {
+	if !ran && err == nil {
+		LogForVideo(video).Infof("Skipping %s: already done for this video", action)
+	}
+}
@@ -0,0 +1,76 @@
+package publishing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostToSlackOnce_SecondCallIsNoOp(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	store := storage.NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	video := &storage.Video{Name: "video", VideoId: "dQw4w9WgXcQ", Title: "Title"}
+
+	require.NoError(t, PostToSlackOnce(context.Background(), store, path, server.URL, video))
+	require.NoError(t, PostToSlackOnce(context.Background(), store, path, server.URL, video))
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	stored, err := store.GetVideo(path)
+	require.NoError(t, err)
+	assert.True(t, stored.SlackPosted)
+}
+
+func TestPostToBlueSkyOnce_SkipsWhenAlreadyPosted(t *testing.T) {
+	store := storage.NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	video := &storage.Video{VideoId: "dQw4w9WgXcQ", Tweet: "Hello", BlueSkyPosted: true}
+
+	cfg := testBlueSkyConfig(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("no request expected, got %s", req.URL.Path)
+		return nil, nil
+	})
+	uri, err := PostToBlueSkyOnce(context.Background(), store, path, cfg, video)
+	require.NoError(t, err)
+	assert.Empty(t, uri)
+}
+
+func TestNotifySponsorsOnce_SecondCallIsNoOp(t *testing.T) {
+	store := storage.NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	video := &storage.Video{VideoId: "dQw4w9WgXcQ", Sponsorship: storage.Sponsorship{Emails: "a@example.com"}}
+	mailer := &countingMailer{}
+
+	sent, err := NotifySponsorsOnce(context.Background(), store, path, mailer, video)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a@example.com"}, sent)
+
+	sent, err = NotifySponsorsOnce(context.Background(), store, path, mailer, video)
+	require.NoError(t, err)
+	assert.Empty(t, sent)
+	assert.Equal(t, 1, mailer.calls)
+	assert.True(t, video.NotifiedSponsors)
+}
+
+// countingMailer counts sent messages and always succeeds.
+type countingMailer struct {
+	calls int
+}
+
+func (m *countingMailer) Send(from string, to []string, subject, body string, attachmentPath string) error {
+	m.calls++
+	return nil
+}
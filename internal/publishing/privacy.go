@@ -0,0 +1,51 @@
+package publishing
+
+import (
+	"fmt"
+	"time"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"google.golang.org/api/youtube/v3"
+)
+
+// YouTube privacy statuses
+const (
+	PrivacyPrivate  = "private"
+	PrivacyPublic   = "public"
+	PrivacyUnlisted = "unlisted"
+)
+
+// ApplyPrivacy sets the privacy status of the YouTube video object from the video's publish
+// date. A date in the future schedules the video: it is uploaded as private and YouTube
+// publishes it at that time. A past or empty date publishes it right away, as unlisted when
+// video.Unlisted is set and public otherwise. An unparsable date leaves the status untouched.
+func ApplyPrivacy(youtubeVideo *youtube.Video, video *storage.Video) error {
+	if youtubeVideo == nil {
+		return fmt.Errorf("youtube video is required to apply privacy")
+	}
+	if video == nil {
+		return fmt.Errorf("video metadata is required to apply privacy")
+	}
+
+	publishAt, err := video.ParsedPublishDate()
+	if err != nil {
+		return err
+	}
+
+	if youtubeVideo.Status == nil {
+		youtubeVideo.Status = &youtube.VideoStatus{}
+	}
+	if !publishAt.IsZero() && publishAt.After(clock.Now()) {
+		youtubeVideo.Status.PrivacyStatus = PrivacyPrivate
+		youtubeVideo.Status.PublishAt = publishAt.Format(time.RFC3339)
+		return nil
+	}
+
+	youtubeVideo.Status.PublishAt = ""
+	if video.Unlisted {
+		youtubeVideo.Status.PrivacyStatus = PrivacyUnlisted
+	} else {
+		youtubeVideo.Status.PrivacyStatus = PrivacyPublic
+	}
+	return nil
+}
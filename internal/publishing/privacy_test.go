@@ -0,0 +1,70 @@
+package publishing
+
+import (
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/youtube/v3"
+)
+
+func TestApplyPrivacy(t *testing.T) {
+	useFakeClock(t) // now is 2025-01-01T00:00Z
+
+	tests := []struct {
+		name              string
+		video             storage.Video
+		status            *youtube.VideoStatus
+		expectedPrivacy   string
+		expectedPublishAt string
+	}{
+		{
+			name:              "Future date schedules a private video",
+			video:             storage.Video{Date: "2025-01-15T10:30"},
+			expectedPrivacy:   PrivacyPrivate,
+			expectedPublishAt: "2025-01-15T10:30:00Z",
+		},
+		{
+			name:            "Past date publishes immediately",
+			video:           storage.Video{Date: "2024-12-31T10:30"},
+			expectedPrivacy: PrivacyPublic,
+		},
+		{
+			name:            "Empty date publishes immediately",
+			video:           storage.Video{},
+			expectedPrivacy: PrivacyPublic,
+		},
+		{
+			name:            "Past date with unlisted",
+			video:           storage.Video{Date: "2024-12-31T10:30", Unlisted: true},
+			expectedPrivacy: PrivacyUnlisted,
+		},
+		{
+			name:            "Existing schedule is cleared",
+			video:           storage.Video{},
+			status:          &youtube.VideoStatus{PrivacyStatus: PrivacyPrivate, PublishAt: "2024-06-01T00:00:00Z"},
+			expectedPrivacy: PrivacyPublic,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			youtubeVideo := &youtube.Video{Status: tt.status}
+
+			require.NoError(t, ApplyPrivacy(youtubeVideo, &tt.video))
+			require.NotNil(t, youtubeVideo.Status)
+			assert.Equal(t, tt.expectedPrivacy, youtubeVideo.Status.PrivacyStatus)
+			assert.Equal(t, tt.expectedPublishAt, youtubeVideo.Status.PublishAt)
+		})
+	}
+}
+
+func TestApplyPrivacy_Errors(t *testing.T) {
+	assert.Error(t, ApplyPrivacy(nil, &storage.Video{}))
+	assert.Error(t, ApplyPrivacy(&youtube.Video{}, nil))
+
+	youtubeVideo := &youtube.Video{}
+	assert.Error(t, ApplyPrivacy(youtubeVideo, &storage.Video{Date: "next week"}))
+	assert.Nil(t, youtubeVideo.Status, "an invalid date must leave the status untouched")
+}
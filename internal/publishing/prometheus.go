@@ -0,0 +1,142 @@
+package publishing
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "youtube"
+
+// MetricsCollector exposes a Metrics instance as Prometheus counters and gauges.
+// Values are read from the atomic counters on every scrape, so no extra bookkeeping is needed.
+type MetricsCollector struct {
+	metrics *Metrics
+
+	languageSetSuccess     *prometheus.Desc
+	languageSetFailure     *prometheus.Desc
+	uploadSuccess          *prometheus.Desc
+	uploadFailure          *prometheus.Desc
+	languageValidation     *prometheus.Desc
+	languageFallback       *prometheus.Desc
+	captionUploadSuccess   *prometheus.Desc
+	captionUploadFailure   *prometheus.Desc
+	categoryFallback       *prometheus.Desc
+	thumbnailSetSuccess    *prometheus.Desc
+	thumbnailSetFailure    *prometheus.Desc
+	blueSkyPostSuccess     *prometheus.Desc
+	blueSkyPostFailure     *prometheus.Desc
+	metadataUpdateSuccess  *prometheus.Desc
+	metadataUpdateFailure  *prometheus.Desc
+	bytesUploaded          *prometheus.Desc
+	languageSetSuccessRate *prometheus.Desc
+	uploadSuccessRate      *prometheus.Desc
+}
+
+// NewMetricsCollector creates a Prometheus collector backed by the given metrics.
+func NewMetricsCollector(m *Metrics) *MetricsCollector {
+	return &MetricsCollector{
+		metrics: m,
+		languageSetSuccess: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "language_set_success_total"),
+			"Total number of successful language settings.", nil, nil),
+		languageSetFailure: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "language_set_failure_total"),
+			"Total number of failed language settings.", nil, nil),
+		uploadSuccess: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "upload_success_total"),
+			"Total number of successful video uploads.", nil, nil),
+		uploadFailure: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "upload_failure_total"),
+			"Total number of failed video uploads.", nil, nil),
+		languageValidation: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "language_validation_total"),
+			"Total number of language validations.", nil, nil),
+		languageFallback: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "language_fallback_total"),
+			"Total number of language fallbacks to the default language.", nil, nil),
+		captionUploadSuccess: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "caption_upload_success_total"),
+			"Total number of successful caption uploads.", nil, nil),
+		captionUploadFailure: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "caption_upload_failure_total"),
+			"Total number of failed caption uploads.", nil, nil),
+		categoryFallback: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "category_fallback_total"),
+			"Total number of category fallbacks to the default category.", nil, nil),
+		thumbnailSetSuccess: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "thumbnail_set_success_total"),
+			"Total number of successful thumbnail uploads.", nil, nil),
+		thumbnailSetFailure: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "thumbnail_set_failure_total"),
+			"Total number of failed thumbnail uploads.", nil, nil),
+		blueSkyPostSuccess: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "bluesky_post_success_total"),
+			"Total number of successful BlueSky posts.", nil, nil),
+		blueSkyPostFailure: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "bluesky_post_failure_total"),
+			"Total number of failed BlueSky posts.", nil, nil),
+		metadataUpdateSuccess: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "metadata_update_success_total"),
+			"Total number of successful video metadata updates.", nil, nil),
+		metadataUpdateFailure: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "metadata_update_failure_total"),
+			"Total number of failed video metadata updates.", nil, nil),
+		bytesUploaded: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "uploaded_bytes_total"),
+			"Total number of video bytes uploaded.", nil, nil),
+		languageSetSuccessRate: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "language_set_success_rate"),
+			"Ratio of successful language settings to all attempts (0.0 to 1.0).", nil, nil),
+		uploadSuccessRate: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "upload_success_rate"),
+			"Ratio of successful uploads to all attempts (0.0 to 1.0).", nil, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.languageSetSuccess
+	ch <- c.languageSetFailure
+	ch <- c.uploadSuccess
+	ch <- c.uploadFailure
+	ch <- c.languageValidation
+	ch <- c.languageFallback
+	ch <- c.captionUploadSuccess
+	ch <- c.captionUploadFailure
+	ch <- c.categoryFallback
+	ch <- c.thumbnailSetSuccess
+	ch <- c.thumbnailSetFailure
+	ch <- c.blueSkyPostSuccess
+	ch <- c.blueSkyPostFailure
+	ch <- c.metadataUpdateSuccess
+	ch <- c.metadataUpdateFailure
+	ch <- c.bytesUploaded
+	ch <- c.languageSetSuccessRate
+	ch <- c.uploadSuccessRate
+}
+
+// Collect implements prometheus.Collector.
+func (c *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(c.languageSetSuccess, prometheus.CounterValue, float64(c.metrics.GetLanguageSetSuccess()))
+	ch <- prometheus.MustNewConstMetric(c.languageSetFailure, prometheus.CounterValue, float64(c.metrics.GetLanguageSetFailure()))
+	ch <- prometheus.MustNewConstMetric(c.uploadSuccess, prometheus.CounterValue, float64(c.metrics.GetUploadSuccess()))
+	ch <- prometheus.MustNewConstMetric(c.uploadFailure, prometheus.CounterValue, float64(c.metrics.GetUploadFailure()))
+	ch <- prometheus.MustNewConstMetric(c.languageValidation, prometheus.CounterValue, float64(c.metrics.GetLanguageValidation()))
+	ch <- prometheus.MustNewConstMetric(c.languageFallback, prometheus.CounterValue, float64(c.metrics.GetLanguageFallback()))
+	ch <- prometheus.MustNewConstMetric(c.captionUploadSuccess, prometheus.CounterValue, float64(c.metrics.GetCaptionUploadSuccess()))
+	ch <- prometheus.MustNewConstMetric(c.captionUploadFailure, prometheus.CounterValue, float64(c.metrics.GetCaptionUploadFailure()))
+	ch <- prometheus.MustNewConstMetric(c.categoryFallback, prometheus.CounterValue, float64(c.metrics.GetCategoryFallback()))
+	ch <- prometheus.MustNewConstMetric(c.thumbnailSetSuccess, prometheus.CounterValue, float64(c.metrics.GetThumbnailSetSuccess()))
+	ch <- prometheus.MustNewConstMetric(c.thumbnailSetFailure, prometheus.CounterValue, float64(c.metrics.GetThumbnailSetFailure()))
+	ch <- prometheus.MustNewConstMetric(c.blueSkyPostSuccess, prometheus.CounterValue, float64(c.metrics.GetBlueSkyPostSuccess()))
+	ch <- prometheus.MustNewConstMetric(c.blueSkyPostFailure, prometheus.CounterValue, float64(c.metrics.GetBlueSkyPostFailure()))
+	ch <- prometheus.MustNewConstMetric(c.metadataUpdateSuccess, prometheus.CounterValue, float64(c.metrics.GetMetadataUpdateSuccess()))
+	ch <- prometheus.MustNewConstMetric(c.metadataUpdateFailure, prometheus.CounterValue, float64(c.metrics.GetMetadataUpdateFailure()))
+	ch <- prometheus.MustNewConstMetric(c.bytesUploaded, prometheus.CounterValue, float64(c.metrics.GetBytesUploaded()))
+	ch <- prometheus.MustNewConstMetric(c.languageSetSuccessRate, prometheus.GaugeValue, c.metrics.GetLanguageSetSuccessRate())
+	ch <- prometheus.MustNewConstMetric(c.uploadSuccessRate, prometheus.GaugeValue, c.metrics.GetUploadSuccessRate())
+}
+
+// RegisterMetrics registers a collector for the global YouTubeMetrics with the given registerer.
+func RegisterMetrics(reg prometheus.Registerer) error {
+	return reg.Register(NewMetricsCollector(YouTubeMetrics))
+}
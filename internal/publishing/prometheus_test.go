@@ -0,0 +1,124 @@
+package publishing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsCollector_Export(t *testing.T) {
+	metrics := &Metrics{}
+	metrics.IncLanguageSetSuccess()
+	metrics.IncLanguageSetSuccess()
+	metrics.IncLanguageSetSuccess()
+	metrics.IncLanguageSetFailure()
+	metrics.IncUploadSuccess()
+	metrics.IncUploadFailure()
+	metrics.IncLanguageValidation()
+	metrics.IncLanguageFallback()
+	metrics.IncLanguageFallback()
+	metrics.IncCaptionUploadSuccess()
+
+	expected := `
+# HELP youtube_bluesky_post_failure_total Total number of failed BlueSky posts.
+# TYPE youtube_bluesky_post_failure_total counter
+youtube_bluesky_post_failure_total 0
+# HELP youtube_bluesky_post_success_total Total number of successful BlueSky posts.
+# TYPE youtube_bluesky_post_success_total counter
+youtube_bluesky_post_success_total 0
+# HELP youtube_caption_upload_failure_total Total number of failed caption uploads.
+# TYPE youtube_caption_upload_failure_total counter
+youtube_caption_upload_failure_total 0
+# HELP youtube_caption_upload_success_total Total number of successful caption uploads.
+# TYPE youtube_caption_upload_success_total counter
+youtube_caption_upload_success_total 1
+# HELP youtube_category_fallback_total Total number of category fallbacks to the default category.
+# TYPE youtube_category_fallback_total counter
+youtube_category_fallback_total 0
+# HELP youtube_language_fallback_total Total number of language fallbacks to the default language.
+# TYPE youtube_language_fallback_total counter
+youtube_language_fallback_total 2
+# HELP youtube_language_set_failure_total Total number of failed language settings.
+# TYPE youtube_language_set_failure_total counter
+youtube_language_set_failure_total 1
+# HELP youtube_language_set_success_rate Ratio of successful language settings to all attempts (0.0 to 1.0).
+# TYPE youtube_language_set_success_rate gauge
+youtube_language_set_success_rate 0.75
+# HELP youtube_language_set_success_total Total number of successful language settings.
+# TYPE youtube_language_set_success_total counter
+youtube_language_set_success_total 3
+# HELP youtube_language_validation_total Total number of language validations.
+# TYPE youtube_language_validation_total counter
+youtube_language_validation_total 1
+# HELP youtube_metadata_update_failure_total Total number of failed video metadata updates.
+# TYPE youtube_metadata_update_failure_total counter
+youtube_metadata_update_failure_total 0
+# HELP youtube_metadata_update_success_total Total number of successful video metadata updates.
+# TYPE youtube_metadata_update_success_total counter
+youtube_metadata_update_success_total 0
+# HELP youtube_thumbnail_set_failure_total Total number of failed thumbnail uploads.
+# TYPE youtube_thumbnail_set_failure_total counter
+youtube_thumbnail_set_failure_total 0
+# HELP youtube_thumbnail_set_success_total Total number of successful thumbnail uploads.
+# TYPE youtube_thumbnail_set_success_total counter
+youtube_thumbnail_set_success_total 0
+# HELP youtube_upload_failure_total Total number of failed video uploads.
+# TYPE youtube_upload_failure_total counter
+youtube_upload_failure_total 1
+# HELP youtube_upload_success_rate Ratio of successful uploads to all attempts (0.0 to 1.0).
+# TYPE youtube_upload_success_rate gauge
+youtube_upload_success_rate 0.5
+# HELP youtube_upload_success_total Total number of successful video uploads.
+# TYPE youtube_upload_success_total counter
+youtube_upload_success_total 1
+# HELP youtube_uploaded_bytes_total Total number of video bytes uploaded.
+# TYPE youtube_uploaded_bytes_total counter
+youtube_uploaded_bytes_total 0
+`
+
+	err := testutil.CollectAndCompare(NewMetricsCollector(metrics), strings.NewReader(expected))
+	assert.NoError(t, err)
+}
+
+func TestMetricsCollector_TracksCounters(t *testing.T) {
+	metrics := &Metrics{}
+	collector := NewMetricsCollector(metrics)
+
+	assert.Equal(t, 18, testutil.CollectAndCount(collector))
+
+	metrics.IncUploadSuccess()
+	metrics.IncUploadSuccess()
+
+	err := testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP youtube_upload_success_total Total number of successful video uploads.
+# TYPE youtube_upload_success_total counter
+youtube_upload_success_total 2
+`), "youtube_upload_success_total")
+	assert.NoError(t, err)
+
+	metrics.Reset()
+
+	err = testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP youtube_upload_success_total Total number of successful video uploads.
+# TYPE youtube_upload_success_total counter
+youtube_upload_success_total 0
+`), "youtube_upload_success_total")
+	assert.NoError(t, err)
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	require.NoError(t, RegisterMetrics(reg))
+
+	// Registering the same collector twice should be rejected by the registry
+	assert.Error(t, RegisterMetrics(reg))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	assert.Len(t, families, 18)
+}
@@ -0,0 +1,305 @@
+package publishing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+// defaultYouTubeBasePath is the base URL of the YouTube Data API.
+const defaultYouTubeBasePath = "https://youtube.googleapis.com/"
+
+// resumableUploadAttempts is the default number of attempts ResumableUploader makes.
+const resumableUploadAttempts = 5
+
+// ResumableUploader uploads video files with YouTube's resumable upload protocol. The upload
+// session and the confirmed offset are saved in a sidecar file next to the video, so when a
+// network or server error interrupts an upload, the next attempt (even from a new process)
+// continues from where YouTube stopped receiving instead of starting over.
+type ResumableUploader struct {
+	Client      *http.Client // Authenticated client, such as the one returned by getClient
+	BasePath    string       // API base URL, the public YouTube endpoint when empty
+	ChunkSize   int64        // Bytes sent per request, uploadChunkSize when zero
+	MaxAttempts int          // Attempts before giving up, resumableUploadAttempts when zero
+}
+
+// uploadStream uploads the video read from media with the uploader, which only sends files, by
+// spooling media to a temporary file first, retrying like upload. The file is removed once the
+// upload is over.
+func (u *ResumableUploader) uploadStream(ctx context.Context, upload *youtube.Video, media io.Reader, progress ProgressFunc, policy RetryPolicy) (*youtube.Video, error) {
+	file, err := os.CreateTemp("", "youtube-upload-*")
+	if err != nil {
+		return nil, NewUploadError("", fmt.Errorf("failed to buffer the video stream: %w", err))
+	}
+	path := file.Name()
+	defer os.Remove(UploadSessionPath(path))
+	defer os.Remove(path)
+
+	_, err = io.Copy(file, media)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, NewUploadError("", fmt.Errorf("failed to buffer the video stream: %w", err))
+	}
+	return u.upload(ctx, upload, path, progress, policy)
+}
+
+// uploadSession is the sidecar state of an unfinished resumable upload.
+type uploadSession struct {
+	SessionURI string    `json:"sessionUri"`
+	Size       int64     `json:"size"`
+	ModTime    time.Time `json:"modTime"`
+	Offset     int64     `json:"offset"`
+}
+
+// UploadSessionPath returns the sidecar file used to resume uploads of the given video file.
+func UploadSessionPath(videoPath string) string {
+	return videoPath + ".upload.json"
+}
+
+// Upload sends the file at path as a new video described by upload and returns the created
+// video. Network and server errors are retried with backoff, resuming the saved session;
+// other errors fail immediately. The sidecar file is removed once the upload completes.
+func (u *ResumableUploader) Upload(ctx context.Context, upload *youtube.Video, path string, progress ProgressFunc) (*youtube.Video, error) {
+	return u.upload(ctx, upload, path, progress, RetryPolicy{})
+}
+
+// resumableRetryPolicy is the policy of Upload: DefaultRetryPolicy with resumableUploadAttempts.
+func resumableRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = resumableUploadAttempts
+	return policy
+}
+
+// upload implements Upload, retrying with policy, whose zero fields are taken from
+// resumableRetryPolicy. u.MaxAttempts, when set, takes precedence over the policy's attempts.
+func (u *ResumableUploader) upload(ctx context.Context, upload *youtube.Video, path string, progress ProgressFunc, policy RetryPolicy) (*youtube.Video, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, NewUploadError("", fmt.Errorf("video file %s is not accessible: %w", path, err))
+	}
+
+	var reporter *progressReporter
+	if progress != nil {
+		reporter = &progressReporter{report: progress, total: info.Size()}
+	}
+	policy = policy.withDefaults(resumableRetryPolicy())
+	if u.MaxAttempts != 0 {
+		policy.MaxAttempts = u.MaxAttempts
+	}
+
+	var result *youtube.Video
+	err = retryWithBackoff(ctx, func() error {
+		var err error
+		result, err = u.attempt(ctx, upload, path, info, reporter)
+		return err
+	}, policy, func(yErr *YouTubeError) bool {
+		return yErr.Type == ErrorTypeNetwork || yErr.Type == ErrorTypeServer
+	})
+	if err != nil {
+		return nil, CategorizeError(err)
+	}
+
+	if err := os.Remove(UploadSessionPath(path)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		LogYouTubeWarn("Failed to remove upload session file for %s: %v", path, err)
+	}
+	if reporter != nil {
+		reporter.update(info.Size(), true)
+	}
+	return result, nil
+}
+
+// attempt resumes the saved session, or starts a new one, and sends the rest of the file.
+func (u *ResumableUploader) attempt(ctx context.Context, upload *youtube.Video, path string, info os.FileInfo, reporter *progressReporter) (*youtube.Video, error) {
+	session, offset, video, err := u.resume(ctx, path, info)
+	if err != nil || video != nil {
+		return video, err
+	}
+	if session == nil {
+		if session, err = u.start(ctx, upload, path, info); err != nil {
+			return nil, err
+		}
+		offset = 0
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, NewUploadError("", fmt.Errorf("failed to open video file %s: %w", path, err))
+	}
+	defer file.Close()
+
+	chunkSize := u.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = int64(uploadChunkSize)
+	}
+	for {
+		if reporter != nil {
+			reporter.update(offset, false)
+		}
+		n := min(chunkSize, session.Size-offset)
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid resumable upload state: all %d bytes sent but the upload did not complete", session.Size)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, session.SessionURI, io.NewSectionReader(file, offset, n))
+		if err != nil {
+			return nil, fmt.Errorf("invalid resumable upload request: %w", err)
+		}
+		req.ContentLength = n
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+n-1, session.Size))
+
+		next, video, err := u.send(req)
+		if err != nil || video != nil {
+			return video, err
+		}
+		offset = next
+		session.Offset = offset
+		saveUploadSession(path, session)
+	}
+}
+
+// resume loads the saved session for path and asks YouTube how much of it was received. It
+// returns a nil session when there is nothing to resume: no sidecar, a file that changed since,
+// or a session YouTube no longer knows. A non-nil video means the upload had already finished.
+func (u *ResumableUploader) resume(ctx context.Context, path string, info os.FileInfo) (*uploadSession, int64, *youtube.Video, error) {
+	data, err := os.ReadFile(UploadSessionPath(path))
+	if err != nil {
+		return nil, 0, nil, nil
+	}
+	var session uploadSession
+	if err := json.Unmarshal(data, &session); err != nil || session.SessionURI == "" ||
+		session.Size != info.Size() || !session.ModTime.Equal(info.ModTime()) {
+		LogYouTubeWarn("Discarding stale upload session for %s", path)
+		return nil, 0, nil, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, session.SessionURI, http.NoBody)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("invalid resumable upload request: %w", err)
+	}
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", session.Size))
+
+	offset, video, err := u.send(req)
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && (apiErr.Code == http.StatusNotFound || apiErr.Code == http.StatusGone) {
+		LogYouTubeWarn("Upload session for %s has expired, starting over", path)
+		return nil, 0, nil, nil
+	}
+	if err != nil || video != nil {
+		return nil, 0, video, err
+	}
+	LogYouTubeInfo("Resuming upload of %s at byte %d of %d", path, offset, session.Size)
+	return &session, offset, nil, nil
+}
+
+// start opens a new upload session for the file and saves it to the sidecar.
+func (u *ResumableUploader) start(ctx context.Context, upload *youtube.Video, path string, info os.FileInfo) (*uploadSession, error) {
+	body, err := json.Marshal(upload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid video metadata: %w", err)
+	}
+	basePath := u.BasePath
+	if basePath == "" {
+		basePath = defaultYouTubeBasePath
+	}
+	url := strings.TrimRight(basePath, "/") + "/upload/youtube/v3/videos?uploadType=resumable&part=snippet,status"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("invalid resumable upload request: %w", err)
+	}
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("X-Upload-Content-Type", contentType)
+	req.Header.Set("X-Upload-Content-Length", strconv.FormatInt(info.Size(), 10))
+
+	resp, err := u.client().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("network error starting resumable upload: %w", err)
+	}
+	defer resp.Body.Close()
+	if err := googleapi.CheckResponse(resp); err != nil {
+		return nil, err
+	}
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return nil, fmt.Errorf("invalid resumable upload response: missing session URI")
+	}
+
+	session := &uploadSession{SessionURI: location, Size: info.Size(), ModTime: info.ModTime()}
+	saveUploadSession(path, session)
+	return session, nil
+}
+
+// send performs a request against an upload session. An incomplete upload returns the offset
+// YouTube has stored so far; a finished one returns the created video.
+func (u *ResumableUploader) send(req *http.Request) (int64, *youtube.Video, error) {
+	// Ask for "308 Resume Incomplete" to be reported as 200 with an override header, so the
+	// HTTP client doesn't treat it as a redirect.
+	req.Header.Set("X-GUploader-No-308", "yes")
+	resp, err := u.client().Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("network error during resumable upload: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusPermanentRedirect || resp.Header.Get("X-Http-Status-Code-Override") == "308" {
+		return receivedBytes(resp.Header.Get("Range")), nil, nil
+	}
+	if err := googleapi.CheckResponse(resp); err != nil {
+		return 0, nil, err
+	}
+	var video youtube.Video
+	if err := json.NewDecoder(resp.Body).Decode(&video); err != nil {
+		return 0, nil, fmt.Errorf("invalid resumable upload response: %w", err)
+	}
+	return 0, &video, nil
+}
+
+func (u *ResumableUploader) client() *http.Client {
+	if u.Client == nil {
+		return http.DefaultClient
+	}
+	return u.Client
+}
+
+// receivedBytes parses a "bytes=0-N" Range header into the number of bytes received.
+func receivedBytes(rangeHeader string) int64 {
+	_, last, found := strings.Cut(rangeHeader, "-")
+	if !found {
+		return 0
+	}
+	end, err := strconv.ParseInt(last, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return end + 1
+}
+
+// saveUploadSession writes the session sidecar. A failure only costs the ability to resume,
+// so it is logged rather than returned.
+func saveUploadSession(path string, session *uploadSession) {
+	data, err := json.MarshalIndent(session, "", "  ")
+	if err == nil {
+		err = os.WriteFile(UploadSessionPath(path), data, 0644)
+	}
+	if err != nil {
+		LogYouTubeWarn("Failed to save upload session for %s, a failed upload will start over: %v", path, err)
+	}
+}
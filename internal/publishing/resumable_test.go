@@ -0,0 +1,224 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/youtube/v3"
+)
+
+// fakeUploadServer emulates YouTube's resumable upload endpoint, recording the offsets of the
+// chunks it receives. failChunkAt makes the first chunk starting at that offset fail with a 503.
+type fakeUploadServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	received    int64
+	initiations int
+	chunkStarts []int64
+	failChunkAt int64
+	metadata    youtube.Video
+}
+
+func newFakeUploadServer(t *testing.T, failChunkAt int64) *fakeUploadServer {
+	t.Helper()
+	f := &fakeUploadServer{failChunkAt: failChunkAt}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeUploadServer) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method == http.MethodPost {
+		f.initiations++
+		json.NewDecoder(r.Body).Decode(&f.metadata)
+		w.Header().Set("Location", f.URL+"/session")
+		return
+	}
+
+	var start, end, total int64
+	contentRange := r.Header.Get("Content-Range")
+	if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%d", &start, &end, &total); err != nil {
+		// Status query: "bytes */total"
+		total, _ = strconv.ParseInt(strings.TrimPrefix(contentRange, "bytes */"), 10, 64)
+		f.reply(w, total)
+		return
+	}
+
+	f.chunkStarts = append(f.chunkStarts, start)
+	io.Copy(io.Discard, r.Body)
+	if start == f.failChunkAt {
+		f.failChunkAt = -1
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	f.received = end + 1
+	f.reply(w, total)
+}
+
+// reply reports the upload as incomplete, or returns the created video once all bytes arrived.
+func (f *fakeUploadServer) reply(w http.ResponseWriter, total int64) {
+	if f.received < total {
+		if f.received > 0 {
+			w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", f.received-1))
+		}
+		w.Header().Set("X-Http-Status-Code-Override", "308")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"id": "new-video-id"}`))
+}
+
+// writeTestVideoFile creates an mp4 file of the given size, starting with a valid header, and
+// returns its path.
+func writeTestVideoFile(t *testing.T, size int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "video.mp4")
+	content := make([]byte, size)
+	copy(content, mp4Header)
+	require.NoError(t, os.WriteFile(path, content, 0644))
+	return path
+}
+
+func TestResumableUploader_UploadsInChunks(t *testing.T) {
+	server := newFakeUploadServer(t, -1)
+	path := writeTestVideoFile(t, 250)
+
+	var progress []int64
+	uploader := &ResumableUploader{Client: server.Client(), BasePath: server.URL + "/", ChunkSize: 100}
+	upload := &youtube.Video{Snippet: &youtube.VideoSnippet{Title: "Title"}}
+	video, err := uploader.Upload(context.Background(), upload, path, func(bytesSent, totalBytes int64) {
+		progress = append(progress, bytesSent)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "new-video-id", video.Id)
+	assert.Equal(t, "Title", server.metadata.Snippet.Title)
+	assert.Equal(t, []int64{0, 100, 200}, server.chunkStarts)
+	assert.Equal(t, int64(250), progress[len(progress)-1])
+	assert.NoFileExists(t, UploadSessionPath(path), "the session file is removed after a completed upload")
+}
+
+func TestResumableUploader_ResumesAfterInterruption(t *testing.T) {
+	useFastBackoff(t)
+	server := newFakeUploadServer(t, 200)
+	path := writeTestVideoFile(t, 250)
+
+	uploader := &ResumableUploader{Client: server.Client(), BasePath: server.URL + "/", ChunkSize: 100}
+	video, err := uploader.Upload(context.Background(), &youtube.Video{}, path, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "new-video-id", video.Id)
+	assert.Equal(t, 1, server.initiations, "the retry must reuse the saved session")
+	assert.Equal(t, []int64{0, 100, 200, 200}, server.chunkStarts, "the retry must continue from the saved offset")
+}
+
+func TestResumableUploader_ResumesSavedSession(t *testing.T) {
+	server := newFakeUploadServer(t, -1)
+	server.received = 100 // a previous process got this far before crashing
+	path := writeTestVideoFile(t, 250)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	saveUploadSession(path, &uploadSession{SessionURI: server.URL + "/session", Size: 250, ModTime: info.ModTime(), Offset: 100})
+
+	uploader := &ResumableUploader{Client: server.Client(), BasePath: server.URL + "/", ChunkSize: 100}
+	video, err := uploader.Upload(context.Background(), &youtube.Video{}, path, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "new-video-id", video.Id)
+	assert.Equal(t, 0, server.initiations)
+	assert.Equal(t, []int64{100, 200}, server.chunkStarts)
+}
+
+func TestResumableUploader_DiscardsStaleSession(t *testing.T) {
+	server := newFakeUploadServer(t, -1)
+	path := writeTestVideoFile(t, 250)
+	saveUploadSession(path, &uploadSession{SessionURI: server.URL + "/old-session", Size: 999, Offset: 100})
+
+	uploader := &ResumableUploader{Client: server.Client(), BasePath: server.URL + "/", ChunkSize: 100}
+	_, err := uploader.Upload(context.Background(), &youtube.Video{}, path, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, server.initiations)
+	assert.Equal(t, []int64{0, 100, 200}, server.chunkStarts)
+}
+
+func TestResumableUploader_DoesNotRetryClientErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": {"code": 400, "message": "invalid title"}}`))
+	}))
+	defer server.Close()
+	path := writeTestVideoFile(t, 10)
+
+	uploader := &ResumableUploader{Client: server.Client(), BasePath: server.URL + "/"}
+	_, err := uploader.Upload(context.Background(), &youtube.Video{}, path, nil)
+	require.Error(t, err)
+
+	yErr := CategorizeError(err)
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+}
+
+// resumableTestConfig returns a config whose uploader sends videos to server resumably.
+func resumableTestConfig(server *fakeUploadServer) *PublishConfig {
+	return &PublishConfig{Uploader: &ResumableUploader{Client: server.Client(), BasePath: server.URL + "/"}}
+}
+
+func TestUploadVideo_ConfigUploader(t *testing.T) {
+	server := newFakeUploadServer(t, -1)
+	video := &storage.Video{Title: "Title", UploadVideo: writeTestVideoFile(t, 250)}
+
+	videoID, err := UploadVideo(context.Background(), nil, video, PublishOptions{Config: resumableTestConfig(server)})
+	require.NoError(t, err)
+
+	assert.Equal(t, "new-video-id", videoID)
+	assert.Equal(t, 1, server.initiations)
+	assert.Equal(t, "Title", server.metadata.Snippet.Title)
+	assert.Equal(t, []int64{0}, server.chunkStarts)
+}
+
+func TestUploadVideoReader_ConfigUploader(t *testing.T) {
+	server := newFakeUploadServer(t, -1)
+	content := append(append([]byte{}, mp4Header...), "streamed frames"...)
+	video := &storage.Video{Title: "Title"}
+
+	videoID, err := UploadVideoReader(context.Background(), newTestYouTubeService(t, failOnRequest(t)), strings.NewReader(string(content)), int64(len(content)), video, resumableTestConfig(server))
+	require.NoError(t, err)
+
+	assert.Equal(t, "new-video-id", videoID)
+	assert.Equal(t, 1, server.initiations)
+	assert.Equal(t, int64(len(content)), server.received)
+}
+
+func TestUploadBatch_ConfigUploader(t *testing.T) {
+	server := newFakeUploadServer(t, -1)
+	video := &storage.Video{Title: "Title", UploadVideo: writeTestVideoFile(t, 250)}
+
+	result := UploadBatch(context.Background(), nil, []*storage.Video{video}, 1, resumableTestConfig(server))
+
+	require.Equal(t, 1, result.Succeeded, "outcome: %+v", result.Outcomes)
+	assert.Equal(t, "new-video-id", result.Outcomes[0].VideoID)
+	assert.Equal(t, 1, server.initiations)
+}
+
+func TestReceivedBytes(t *testing.T) {
+	assert.Equal(t, int64(0), receivedBytes(""))
+	assert.Equal(t, int64(100), receivedBytes("bytes=0-99"))
+	assert.Equal(t, int64(0), receivedBytes("garbage"))
+}
@@ -0,0 +1,141 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Default backoff parameters of DefaultRetryPolicy, replaceable for testing
+var (
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 30 * time.Second
+	retryJitter    = equalJitter
+)
+
+// defaultRetryAttempts is the number of attempts DefaultRetryPolicy allows.
+const defaultRetryAttempts = 3
+
+// RetryPolicy controls how often and how patiently a failing operation is retried.
+type RetryPolicy struct {
+	MaxAttempts int           // Attempts in total, the first one included; below one means a single attempt
+	BaseDelay   time.Duration // Wait after the first failure, doubled after every further failure
+	MaxDelay    time.Duration // Cap on the wait between two attempts
+	Jitter      bool          // Randomize the second half of each wait so concurrent retries spread out
+}
+
+// DefaultRetryPolicy returns the policy suitable for most YouTube API calls: three attempts,
+// waiting one second after the first failure and at most 30 seconds, with jitter. Callers
+// adjust the returned value to tune an operation.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: defaultRetryAttempts,
+		BaseDelay:   retryBaseDelay,
+		MaxDelay:    retryMaxDelay,
+		Jitter:      true,
+	}
+}
+
+// withDefaults returns the policy with its zero attempts and delays taken from defaults, so a
+// partially set policy still backs off. A zero policy is replaced by defaults as a whole.
+func (p RetryPolicy) withDefaults(defaults RetryPolicy) RetryPolicy {
+	if p == (RetryPolicy{}) {
+		return defaults
+	}
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = defaults.MaxAttempts
+	}
+	if p.BaseDelay == 0 {
+		p.BaseDelay = defaults.BaseDelay
+	}
+	if p.MaxDelay == 0 {
+		p.MaxDelay = defaults.MaxDelay
+	}
+	return p
+}
+
+// RetryWithBackoff runs op up to policy.MaxAttempts times, retrying only failures that
+// CategorizeError marks as retryable. Authentication and invalid-request errors stop
+// immediately. Between attempts it waits for the error's RetryAfter hint when present,
+// otherwise with the policy's exponential backoff, and it aborts as soon as ctx is
+// cancelled. The returned error wraps the last *YouTubeError, so callers can inspect it
+// with errors.As.
+func RetryWithBackoff(ctx context.Context, op func() error, policy RetryPolicy) error {
+	return retryWithBackoff(ctx, op, policy, func(yErr *YouTubeError) bool {
+		return yErr.Type != ErrorTypeAuth && yErr.Type != ErrorTypeInvalid && yErr.Retryable
+	})
+}
+
+// retryWithBackoff implements RetryWithBackoff, retrying only the errors for which
+// shouldRetry returns true.
+func retryWithBackoff(ctx context.Context, op func() error, policy RetryPolicy, shouldRetry func(*YouTubeError) bool) error {
+	maxAttempts := max(policy.MaxAttempts, 1)
+
+	var lastErr *YouTubeError
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return retryAborted(attempt-1, err, lastErr)
+		}
+
+		err := op()
+		if err == nil {
+			return nil
+		}
+
+		lastErr = CategorizeError(err)
+		if !shouldRetry(lastErr) {
+			LogYouTubeError(lastErr, "Operation failed with non-retryable error")
+			return fmt.Errorf("operation failed after %d attempt(s): %w", attempt, lastErr)
+		}
+		if attempt == maxAttempts {
+			break
+		}
+
+		delay := policy.backoffDelay(attempt)
+		if lastErr.RetryAfter > 0 {
+			delay = lastErr.RetryAfter
+		}
+		LogYouTubeWarn("Attempt %d/%d failed with %s error, retrying in %s", attempt, maxAttempts, lastErr.Type, delay)
+
+		if err := sleepContext(ctx, clock, delay); err != nil {
+			return retryAborted(attempt, err, lastErr)
+		}
+	}
+
+	LogYouTubeError(lastErr, "Operation failed after exhausting retries")
+	return fmt.Errorf("operation failed after %d attempt(s): %w", maxAttempts, lastErr)
+}
+
+// backoffDelay returns the wait before the next attempt: the base delay doubled for each
+// previous attempt, capped at the maximum, with jitter applied when enabled.
+func (p RetryPolicy) backoffDelay(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if !p.Jitter {
+		return delay
+	}
+	return retryJitter(delay)
+}
+
+// equalJitter keeps half of the delay and randomizes the other half.
+func equalJitter(delay time.Duration) time.Duration {
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + rand.N(half+1)
+}
+
+// retryAborted builds the error returned when the context ends before retries complete.
+func retryAborted(attempts int, ctxErr error, lastErr *YouTubeError) error {
+	if lastErr == nil {
+		return fmt.Errorf("operation aborted before first attempt: %w", ctxErr)
+	}
+	return fmt.Errorf("operation aborted after %d attempt(s): %w (last error: %w)", attempts, ctxErr, lastErr)
+}
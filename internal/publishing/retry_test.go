@@ -0,0 +1,223 @@
+package publishing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setBackoff overrides the retry delays for the duration of a test.
+func setBackoff(t *testing.T, base, max time.Duration) {
+	t.Helper()
+	originalBase, originalMax := retryBaseDelay, retryMaxDelay
+	retryBaseDelay, retryMaxDelay = base, max
+	t.Cleanup(func() {
+		retryBaseDelay, retryMaxDelay = originalBase, originalMax
+	})
+}
+
+// useFastBackoff shortens the retry delays so tests don't sleep noticeably.
+func useFastBackoff(t *testing.T) {
+	t.Helper()
+	setBackoff(t, time.Millisecond, 4*time.Millisecond)
+}
+
+// retryAttempts returns the default retry policy limited to the given number of attempts.
+func retryAttempts(attempts int) RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = attempts
+	return policy
+}
+
+func TestRetryWithBackoff_Success(t *testing.T) {
+	useFastBackoff(t)
+
+	calls := 0
+	err := RetryWithBackoff(context.Background(), func() error {
+		calls++
+		return nil
+	}, retryAttempts(3))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryWithBackoff_AuthStopsImmediately(t *testing.T) {
+	useFastBackoff(t)
+
+	calls := 0
+	err := RetryWithBackoff(context.Background(), func() error {
+		calls++
+		return errors.New("unauthorized: token expired")
+	}, retryAttempts(5))
+
+	require.Error(t, err)
+	assert.Equal(t, 1, calls, "auth errors must not be retried")
+
+	var yErr *YouTubeError
+	require.True(t, errors.As(err, &yErr))
+	assert.Equal(t, ErrorTypeAuth, yErr.Type)
+}
+
+func TestRetryWithBackoff_InvalidStopsImmediately(t *testing.T) {
+	useFastBackoff(t)
+
+	calls := 0
+	err := RetryWithBackoff(context.Background(), func() error {
+		calls++
+		return errors.New("bad request: missing title")
+	}, retryAttempts(5))
+
+	require.Error(t, err)
+	assert.Equal(t, 1, calls, "invalid request errors must not be retried")
+}
+
+func TestRetryWithBackoff_RateLimitRetries(t *testing.T) {
+	useFastBackoff(t)
+
+	calls := 0
+	err := RetryWithBackoff(context.Background(), func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("rate limit exceeded")
+		}
+		return nil
+	}, retryAttempts(5))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryWithBackoff_ExhaustsAttempts(t *testing.T) {
+	useFastBackoff(t)
+
+	calls := 0
+	err := RetryWithBackoff(context.Background(), func() error {
+		calls++
+		return errors.New("quota exceeded")
+	}, retryAttempts(3))
+
+	require.Error(t, err)
+	assert.Equal(t, 3, calls)
+
+	var yErr *YouTubeError
+	require.True(t, errors.As(err, &yErr))
+	assert.Equal(t, ErrorTypeRateLimit, yErr.Type)
+}
+
+func TestRetryWithBackoff_ContextCancelled(t *testing.T) {
+	setBackoff(t, time.Hour, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := RetryWithBackoff(ctx, func() error {
+		calls++
+		cancel()
+		return errors.New("network timeout")
+	}, retryAttempts(5))
+
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	var yErr *YouTubeError
+	require.True(t, errors.As(err, &yErr))
+	assert.Equal(t, ErrorTypeNetwork, yErr.Type)
+}
+
+func TestBackoffDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 4 * time.Second, Jitter: true}
+
+	for attempt, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 10: 4 * time.Second} {
+		delay := policy.backoffDelay(attempt)
+		assert.GreaterOrEqual(t, delay, expected/2, "attempt %d", attempt)
+		assert.LessOrEqual(t, delay, expected, "attempt %d", attempt)
+	}
+}
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicy()
+
+	assert.Equal(t, 3, policy.MaxAttempts)
+	assert.Equal(t, time.Second, policy.BaseDelay)
+	assert.Equal(t, 30*time.Second, policy.MaxDelay)
+	assert.True(t, policy.Jitter)
+}
+
+func TestRetryWithBackoff_PolicyCapsDelay(t *testing.T) {
+	fake := useFakeClock(t)
+	policy := RetryPolicy{MaxAttempts: 6, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	err := RetryWithBackoff(context.Background(), func() error {
+		return errors.New("network timeout")
+	}, policy)
+
+	require.Error(t, err)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, fake.sleeps,
+		"without jitter the delays double until they reach MaxDelay")
+}
+
+func TestRetryWithBackoff_PolicyLimitsAttempts(t *testing.T) {
+	useFakeClock(t)
+
+	for _, maxAttempts := range []int{-1, 0, 1, 2, 7} {
+		calls := 0
+		err := RetryWithBackoff(context.Background(), func() error {
+			calls++
+			return errors.New("network timeout")
+		}, RetryPolicy{MaxAttempts: maxAttempts, BaseDelay: time.Second, MaxDelay: time.Minute, Jitter: true})
+
+		require.Error(t, err)
+		assert.Equal(t, max(maxAttempts, 1), calls, "MaxAttempts %d", maxAttempts)
+	}
+}
+
+func TestRetryWithBackoff_PrefersRetryAfter(t *testing.T) {
+	setBackoff(t, time.Hour, time.Hour)
+
+	calls := 0
+	start := time.Now()
+	err := RetryWithBackoff(context.Background(), func() error {
+		calls++
+		if calls == 1 {
+			return errors.New("quota exceeded, retry after 10ms")
+		}
+		return nil
+	}, retryAttempts(2))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Less(t, time.Since(start), time.Minute, "RetryAfter should override the hour-long computed backoff")
+}
+
+func TestRetryPolicy_WithDefaults(t *testing.T) {
+	defaults := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: time.Minute, Jitter: true}
+
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		expected RetryPolicy
+	}{
+		{name: "Zero policy takes the defaults", policy: RetryPolicy{}, expected: defaults},
+		{
+			name:     "Only attempts set",
+			policy:   RetryPolicy{MaxAttempts: 2},
+			expected: RetryPolicy{MaxAttempts: 2, BaseDelay: time.Second, MaxDelay: time.Minute},
+		},
+		{
+			name:     "Set fields are kept",
+			policy:   RetryPolicy{BaseDelay: 2 * time.Second, MaxDelay: 10 * time.Second, Jitter: true},
+			expected: RetryPolicy{MaxAttempts: 5, BaseDelay: 2 * time.Second, MaxDelay: 10 * time.Second, Jitter: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.withDefaults(defaults))
+		})
+	}
+}
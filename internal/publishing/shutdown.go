@@ -0,0 +1,63 @@
+package publishing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrShuttingDown is returned by the publishing operations started after Shutdown was called.
+var ErrShuttingDown = errors.New("publishing is shutting down")
+
+// operations tracks the publishing operations currently running, so Shutdown can wait for them.
+// The closing flag is set under mu, like every Add, so no operation starts once Shutdown waits.
+var operations struct {
+	mu       sync.Mutex
+	closing  bool
+	inFlight sync.WaitGroup
+}
+
+// trackOperation registers a running operation with Shutdown and returns the function to call
+// once it has ended, or ErrShuttingDown once Shutdown was called.
+func trackOperation() (func(), error) {
+	operations.mu.Lock()
+	defer operations.mu.Unlock()
+	if operations.closing {
+		return nil, ErrShuttingDown
+	}
+	operations.inFlight.Add(1)
+	return operations.inFlight.Done, nil
+}
+
+// Shutdown waits for in-flight uploads, updates, captions and thumbnails to finish, or for ctx
+// to end, then flushes the audit log and logs a final snapshot of YouTubeMetrics, so nothing
+// recorded so far is lost when the process exits. Operations started afterwards fail with
+// ErrShuttingDown. It returns ctx's error when operations were still running, joined with any
+// error flushing the audit log.
+func Shutdown(ctx context.Context) error {
+	operations.mu.Lock()
+	operations.closing = true
+	operations.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		operations.inFlight.Wait()
+		close(done)
+	}()
+
+	var errs []error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		errs = append(errs, fmt.Errorf("operations still in flight at shutdown: %w", ctx.Err()))
+	}
+
+	if a := auditLogger.Load(); a != nil {
+		if err := a.Sync(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	baseEntry().WithField("metrics", YouTubeMetrics.Snapshot()).Info("Final YouTube metrics")
+	return errors.Join(errs...)
+}
@@ -0,0 +1,102 @@
+package publishing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// finalMetricsEntry returns the logged final metrics snapshot, or nil if none was logged.
+func finalMetricsEntry(t *testing.T, logs string) map[string]interface{} {
+	t.Helper()
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	for scanner.Scan() {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		if entry["msg"] == "Final YouTube metrics" {
+			metrics, ok := entry["metrics"].(map[string]interface{})
+			require.True(t, ok, "the snapshot is logged as a structured field")
+			return metrics
+		}
+	}
+	return nil
+}
+
+// reopenAfterShutdown lets operations start again once the test has shut publishing down.
+func reopenAfterShutdown(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		operations.mu.Lock()
+		defer operations.mu.Unlock()
+		operations.closing = false
+	})
+}
+
+// startOperation tracks a fake operation, failing the test if it is rejected.
+func startOperation(t *testing.T) func() {
+	t.Helper()
+	done, err := trackOperation()
+	require.NoError(t, err)
+	return done
+}
+
+func TestShutdown_WaitsForInFlightOperations(t *testing.T) {
+	YouTubeMetrics.Reset()
+	logs := captureLogs(t)
+	path := useAuditLog(t)
+	reopenAfterShutdown(t)
+
+	operationDone := startOperation(t)
+	shutdownDone := make(chan error, 1)
+	go func() {
+		shutdownDone <- Shutdown(context.Background())
+	}()
+
+	select {
+	case <-shutdownDone:
+		t.Fatal("Shutdown returned while an operation was in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	YouTubeMetrics.IncUploadSuccess()
+	audit(AuditOperationUpload, "dQw4w9WgXcQ", nil)
+	operationDone()
+	require.NoError(t, <-shutdownDone)
+
+	metrics := finalMetricsEntry(t, logs.String())
+	require.NotNil(t, metrics, "the final snapshot must be logged")
+	assert.Equal(t, float64(1), metrics["uploadSuccess"], "the snapshot includes the operation that finished during shutdown")
+	assert.Len(t, readAuditRecords(t, path), 1)
+}
+
+func TestShutdown_ContextDeadline(t *testing.T) {
+	logs := captureLogs(t)
+	reopenAfterShutdown(t)
+	operationDone := startOperation(t)
+	t.Cleanup(operationDone)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := Shutdown(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotNil(t, finalMetricsEntry(t, logs.String()), "the snapshot is logged even when operations are still running")
+}
+
+func TestShutdown_RejectsNewOperations(t *testing.T) {
+	captureLogs(t)
+	reopenAfterShutdown(t)
+	require.NoError(t, Shutdown(context.Background()))
+
+	_, err := trackOperation()
+	assert.ErrorIs(t, err, ErrShuttingDown)
+
+	err = SetThumbnail(context.Background(), nil, "dQw4w9WgXcQ", "thumbnail.png")
+	assert.ErrorIs(t, err, ErrShuttingDown, "operations started after Shutdown are rejected before any API call")
+}
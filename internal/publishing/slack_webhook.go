@@ -0,0 +1,98 @@
+package publishing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"google.golang.org/api/googleapi"
+)
+
+// slackWebhookAttempts is the number of times PostToSlack tries to deliver a message.
+const slackWebhookAttempts = 3
+
+// slackEscaper escapes the characters Slack treats as control sequences in message text.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// PostToSlack announces the video on a Slack incoming webhook with its title, link and tagline.
+// Network failures and 5xx responses are retried with backoff; everything else fails right away.
+// The context bounds all attempts, and failures are returned as a categorized *YouTubeError.
+func PostToSlack(ctx context.Context, webhookURL string, video *storage.Video) error {
+	if webhookURL == "" {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: "Slack webhook URL is required"}
+	}
+	if video == nil || video.VideoId == "" {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: "video ID is required to post to Slack"}
+	}
+
+	if skipForDryRun("post video ID %s to Slack: %q", video.VideoId, slackMessage(video)) {
+		return nil
+	}
+
+	payload, err := json.Marshal(map[string]string{"text": slackMessage(video)})
+	if err != nil {
+		return &YouTubeError{Type: ErrorTypeInternal, Message: "failed to encode Slack message", OriginalError: err, VideoID: video.VideoId}
+	}
+
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = slackWebhookAttempts
+	err = retryWithBackoff(ctx, func() error {
+		return sendSlackWebhook(ctx, webhookURL, payload)
+	}, policy, func(yErr *YouTubeError) bool {
+		return yErr.Type == ErrorTypeNetwork || yErr.Type == ErrorTypeServer
+	})
+	if err != nil {
+		yErr := CategorizeError(err)
+		yErr.VideoID = video.VideoId
+		return yErr
+	}
+
+	LogYouTubeInfo("Posted video ID %s to Slack", video.VideoId)
+	return nil
+}
+
+// slackMessage formats the announcement text for a video.
+func slackMessage(video *storage.Video) string {
+	title := video.Title
+	if title == "" {
+		title = video.Name
+	}
+
+	lines := []string{"*" + slackEscaper.Replace(title) + "*"}
+	if tagline := strings.TrimSpace(video.Tagline); tagline != "" {
+		lines = append(lines, slackEscaper.Replace(tagline))
+	}
+	lines = append(lines, GetYouTubeURL(video.VideoId))
+	return strings.Join(lines, "\n")
+}
+
+// sendSlackWebhook delivers a single webhook request. Slack answers a successful delivery with
+// a plain "ok" body; non-2xx responses are returned as a *googleapi.Error so CategorizeError
+// can use the status code.
+func sendSlackWebhook(ctx context.Context, webhookURL string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("invalid Slack webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("network error calling Slack webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &googleapi.Error{Code: resp.StatusCode, Message: strings.TrimSpace(string(body)), Header: resp.Header}
+	}
+	if strings.TrimSpace(string(body)) != "ok" {
+		return fmt.Errorf("invalid Slack webhook response: %q", body)
+	}
+	return nil
+}
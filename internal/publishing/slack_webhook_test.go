@@ -0,0 +1,114 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostToSlack_Success(t *testing.T) {
+	var message map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	video := &storage.Video{VideoId: "dQw4w9WgXcQ", Title: "Kubernetes <Tips> & Tricks", Tagline: "Ship faster."}
+	require.NoError(t, PostToSlack(context.Background(), server.URL, video))
+
+	assert.Equal(t, "*Kubernetes &lt;Tips&gt; &amp; Tricks*\nShip faster.\nhttps://youtu.be/dQw4w9WgXcQ", message["text"])
+}
+
+func TestPostToSlack_ServerErrorIsRetried(t *testing.T) {
+	useFastBackoff(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("internal_error"))
+	}))
+	defer server.Close()
+
+	err := PostToSlack(context.Background(), server.URL, &storage.Video{VideoId: "dQw4w9WgXcQ", Title: "Title"})
+	require.Error(t, err)
+
+	var yErr *YouTubeError
+	require.True(t, errors.As(err, &yErr))
+	assert.Equal(t, ErrorTypeServer, yErr.Type)
+	assert.Equal(t, "dQw4w9WgXcQ", yErr.VideoID)
+	assert.Equal(t, int32(slackWebhookAttempts), atomic.LoadInt32(&calls))
+}
+
+func TestPostToSlack_RecoversAfterServerError(t *testing.T) {
+	useFastBackoff(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	require.NoError(t, PostToSlack(context.Background(), server.URL, &storage.Video{VideoId: "dQw4w9WgXcQ", Title: "Title"}))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestPostToSlack_MalformedResponseIsNotRetried(t *testing.T) {
+	useFastBackoff(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte("<html>captive portal</html>"))
+	}))
+	defer server.Close()
+
+	err := PostToSlack(context.Background(), server.URL, &storage.Video{VideoId: "dQw4w9WgXcQ", Title: "Title"})
+	require.Error(t, err)
+
+	var yErr *YouTubeError
+	require.True(t, errors.As(err, &yErr))
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestPostToSlack_ContextCancelled(t *testing.T) {
+	setBackoff(t, time.Hour, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	err := PostToSlack(ctx, server.URL, &storage.Video{VideoId: "dQw4w9WgXcQ", Title: "Title"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPostToSlack_RequiresWebhookAndVideoID(t *testing.T) {
+	err := PostToSlack(context.Background(), "", &storage.Video{VideoId: "dQw4w9WgXcQ"})
+	var yErr *YouTubeError
+	require.True(t, errors.As(err, &yErr))
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+
+	err = PostToSlack(context.Background(), "https://hooks.slack.test/x", &storage.Video{})
+	require.True(t, errors.As(err, &yErr))
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+}
@@ -0,0 +1,70 @@
+package publishing
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"google.golang.org/api/youtube/v3"
+)
+
+// MaxTagBytes is YouTube's limit on the combined size of all tags of a video.
+const MaxTagBytes = 500
+
+// MaxTagLength is the longest tag, in characters, YouTube keeps; longer ones are silently dropped.
+const MaxTagLength = 30
+
+// ApplyTags splits the comma-separated video tags, trims and dedupes them
+// (case-insensitively), and sets them on the YouTube video. Tags longer than MaxTagLength
+// are dropped and returned as tooLong, and tags that would push the total past maxTotalBytes
+// (MaxTagBytes when not positive) are dropped and returned as overBudget, so callers can log
+// the two causes apart.
+func ApplyTags(youtubeVideo *youtube.Video, video *storage.Video, maxTotalBytes int) (overBudget, tooLong []string) {
+	if youtubeVideo == nil || video == nil {
+		return nil, nil
+	}
+	if maxTotalBytes <= 0 {
+		maxTotalBytes = MaxTagBytes
+	}
+
+	var tags []string
+	seen := make(map[string]bool)
+	total := 0
+	for _, tag := range strings.Split(video.Tags, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+		seen[strings.ToLower(tag)] = true
+		if utf8.RuneCountInString(tag) > MaxTagLength {
+			tooLong = append(tooLong, tag)
+			continue
+		}
+
+		size := tagBytes(tag)
+		if len(tags) > 0 {
+			size++ // comma separator
+		}
+		if total+size > maxTotalBytes {
+			overBudget = append(overBudget, tag)
+			continue
+		}
+		total += size
+		tags = append(tags, tag)
+	}
+
+	if youtubeVideo.Snippet == nil {
+		youtubeVideo.Snippet = &youtube.VideoSnippet{}
+	}
+	youtubeVideo.Snippet.Tags = tags
+	return overBudget, tooLong
+}
+
+// tagBytes returns how much a tag counts towards the limit. YouTube wraps tags
+// containing spaces in quotes, which count as well.
+func tagBytes(tag string) int {
+	if strings.Contains(tag, " ") {
+		return len(tag) + 2
+	}
+	return len(tag)
+}
@@ -0,0 +1,95 @@
+package publishing
+
+import (
+	"strings"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/youtube/v3"
+)
+
+func TestApplyTags(t *testing.T) {
+	tests := []struct {
+		name            string
+		tags            string
+		maxTotalBytes   int
+		expectedTags    []string
+		expectedDropped []string
+		expectedTooLong []string
+	}{
+		{
+			name:         "Trims and dedupes",
+			tags:         " go , devops,Go,  , kubernetes,devops ",
+			expectedTags: []string{"go", "devops", "kubernetes"},
+		},
+		{
+			name:         "Empty tags",
+			tags:         "",
+			expectedTags: nil,
+		},
+		{
+			name:            "Drops tags past the byte limit",
+			tags:            "aaaa,bbbb,cccc",
+			maxTotalBytes:   9,
+			expectedTags:    []string{"aaaa", "bbbb"},
+			expectedDropped: []string{"cccc"},
+		},
+		{
+			name:            "Drops tags longer than the per-tag limit",
+			tags:            "go," + strings.Repeat("k", 40) + ",devops",
+			expectedTags:    []string{"go", "devops"},
+			expectedTooLong: []string{strings.Repeat("k", 40)},
+		},
+		{
+			name:         "Tag at the per-tag limit is kept",
+			tags:         strings.Repeat("ü", MaxTagLength),
+			expectedTags: []string{strings.Repeat("ü", MaxTagLength)},
+		},
+		{
+			name:            "Quoted tags count their quotes",
+			tags:            "ab,c d,efg",
+			maxTotalBytes:   7,
+			expectedTags:    []string{"ab", "efg"},
+			expectedDropped: []string{"c d"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			youtubeVideo := &youtube.Video{}
+			dropped, tooLong := ApplyTags(youtubeVideo, &storage.Video{Tags: tt.tags}, tt.maxTotalBytes)
+
+			require.NotNil(t, youtubeVideo.Snippet)
+			assert.Equal(t, tt.expectedTags, youtubeVideo.Snippet.Tags)
+			assert.Equal(t, tt.expectedDropped, dropped)
+			assert.Equal(t, tt.expectedTooLong, tooLong)
+		})
+	}
+}
+
+func TestApplyTags_DefaultLimit(t *testing.T) {
+	var tags []string
+	for i := 0; i < 60; i++ {
+		tags = append(tags, strings.Repeat(string(rune('a'+i%26)), 9)+string(rune('A'+i/26)))
+	}
+
+	youtubeVideo := &youtube.Video{}
+	dropped, tooLong := ApplyTags(youtubeVideo, &storage.Video{Tags: strings.Join(tags, ",")}, 0)
+
+	total := len(strings.Join(youtubeVideo.Snippet.Tags, ","))
+	assert.LessOrEqual(t, total, MaxTagBytes)
+	assert.Len(t, youtubeVideo.Snippet.Tags, 45)
+	assert.Len(t, dropped, 15)
+	assert.Empty(t, tooLong, "tags fine on their own are only dropped for the total budget")
+}
+
+func TestApplyTags_NilSafe(t *testing.T) {
+	dropped, tooLong := ApplyTags(nil, &storage.Video{Tags: "go"}, 0)
+	assert.Nil(t, dropped)
+	assert.Nil(t, tooLong)
+	dropped, tooLong = ApplyTags(&youtube.Video{}, nil, 0)
+	assert.Nil(t, dropped)
+	assert.Nil(t, tooLong)
+}
@@ -0,0 +1,151 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg" // Registers the JPEG decoder for image.DecodeConfig
+	_ "image/png"  // Registers the PNG decoder for image.DecodeConfig
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+// MaxThumbnailBytes is YouTube's size limit for custom thumbnails.
+const MaxThumbnailBytes = 2 * 1024 * 1024
+
+// MinThumbnailWidth is the narrowest thumbnail YouTube accepts; it recommends 1280x720.
+const MinThumbnailWidth = 640
+
+// thumbnailAspectRatio is YouTube's recommended 16:9 thumbnail aspect ratio and
+// thumbnailAspectTolerance how far, relative to it, a thumbnail may deviate.
+const (
+	thumbnailAspectRatio     = 16.0 / 9.0
+	thumbnailAspectTolerance = 0.05
+)
+
+// thumbnailContentTypes maps the supported thumbnail extensions to their MIME types.
+var thumbnailContentTypes = map[string]string{
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+}
+
+// SetThumbnail uploads thumbnailPath as the custom thumbnail of the given video.
+// The file must pass ValidateThumbnail; this check happens before any API call.
+// API failures are returned as a categorized *YouTubeError.
+func SetThumbnail(ctx context.Context, service *youtube.Service, videoID, thumbnailPath string) error {
+	if skipForDryRun("set thumbnail %s for video ID %s", thumbnailPath, videoID) {
+		return nil
+	}
+	done, err := trackOperation()
+	if err != nil {
+		return err
+	}
+	defer done()
+	err = setThumbnail(ctx, service, videoID, thumbnailPath)
+	audit(AuditOperationThumbnail, videoID, err)
+	return err
+}
+
+func setThumbnail(ctx context.Context, service *youtube.Service, videoID, thumbnailPath string) error {
+	contentType, err := validateThumbnail(thumbnailPath)
+	if err != nil {
+		YouTubeMetrics.IncThumbnailSetFailure()
+		return err
+	}
+	if service == nil {
+		YouTubeMetrics.IncThumbnailSetFailure()
+		return fmt.Errorf("youtube service is required to set a thumbnail")
+	}
+	if videoID == "" {
+		YouTubeMetrics.IncThumbnailSetFailure()
+		return fmt.Errorf("video ID is required to set a thumbnail")
+	}
+
+	file, err := os.Open(thumbnailPath)
+	if err != nil {
+		YouTubeMetrics.IncThumbnailSetFailure()
+		return fmt.Errorf("failed to open thumbnail %s: %w", thumbnailPath, err)
+	}
+	defer file.Close()
+
+	_, err = service.Thumbnails.Set(videoID).Media(file, googleapi.ContentType(contentType)).Context(ctx).Do()
+	if err != nil {
+		yErr := CategorizeError(err)
+		yErr.VideoID = videoID
+		LogYouTubeError(yErr, "Failed to set thumbnail")
+		YouTubeMetrics.IncThumbnailSetFailure()
+		return yErr
+	}
+
+	YouTubeMetrics.IncThumbnailSetSuccess()
+	LogYouTubeInfo("Thumbnail %s set for video ID %s", thumbnailPath, videoID)
+	return nil
+}
+
+// validateThumbnailFile checks that the thumbnail exists, has a supported type and fits
+// the size limit, returning its content type.
+func validateThumbnailFile(thumbnailPath string) (string, error) {
+	info, err := os.Stat(thumbnailPath)
+	if err != nil {
+		return "", fmt.Errorf("thumbnail %s is not accessible: %w", thumbnailPath, err)
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("thumbnail %s is a directory", thumbnailPath)
+	}
+	contentType, ok := thumbnailContentTypes[strings.ToLower(filepath.Ext(thumbnailPath))]
+	if !ok {
+		return "", fmt.Errorf("thumbnail %s has unsupported type, expected jpg or png", thumbnailPath)
+	}
+	if info.Size() > MaxThumbnailBytes {
+		return "", fmt.Errorf("thumbnail %s is %d bytes, exceeding the %d byte limit", thumbnailPath, info.Size(), MaxThumbnailBytes)
+	}
+	return contentType, nil
+}
+
+// ValidateThumbnail checks that the thumbnail at path is a JPEG or PNG within
+// MaxThumbnailBytes, at least MinThumbnailWidth pixels wide, with a roughly 16:9 aspect
+// ratio. Only the image header is decoded. Problems are returned as an ErrorTypeInvalid
+// *YouTubeError.
+func ValidateThumbnail(path string) error {
+	_, err := validateThumbnail(path)
+	return err
+}
+
+// validateThumbnail implements ValidateThumbnail, returning the thumbnail's content type.
+func validateThumbnail(path string) (string, error) {
+	invalid := func(message string, err error) error {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: message, OriginalError: err}
+	}
+
+	contentType, err := validateThumbnailFile(path)
+	if err != nil {
+		return "", invalid(err.Error(), nil)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return "", invalid(fmt.Sprintf("failed to open thumbnail %s", path), err)
+	}
+	defer file.Close()
+
+	config, format, err := image.DecodeConfig(file)
+	if err != nil {
+		return "", invalid(fmt.Sprintf("thumbnail %s is not a readable jpg or png image", path), err)
+	}
+	if "image/"+format != contentType {
+		return "", invalid(fmt.Sprintf("thumbnail %s contains a %s image, which doesn't match its extension", path, format), nil)
+	}
+	if config.Width < MinThumbnailWidth {
+		return "", invalid(fmt.Sprintf("thumbnail %s is %dx%d, narrower than the minimum width of %d", path, config.Width, config.Height, MinThumbnailWidth), nil)
+	}
+	ratio := float64(config.Width) / float64(config.Height)
+	if config.Height == 0 || math.Abs(ratio-thumbnailAspectRatio)/thumbnailAspectRatio > thumbnailAspectTolerance {
+		return "", invalid(fmt.Sprintf("thumbnail %s is %dx%d, expected a 16:9 aspect ratio such as 1280x720", path, config.Width, config.Height), nil)
+	}
+	return contentType, nil
+}
@@ -0,0 +1,152 @@
+package publishing
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestThumbnail creates a thumbnail file of the given size and returns its path.
+func writeTestThumbnail(t *testing.T, name string, size int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, make([]byte, size), 0644))
+	return path
+}
+
+// writeTestImage encodes a blank width x height image as a png or jpeg, following the
+// extension of name, and returns its path.
+func writeTestImage(t *testing.T, name string, width, height int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	if filepath.Ext(name) == ".png" {
+		require.NoError(t, png.Encode(file, img))
+	} else {
+		require.NoError(t, jpeg.Encode(file, img, nil))
+	}
+	return path
+}
+
+func TestSetThumbnail_Success(t *testing.T) {
+	YouTubeMetrics.Reset()
+	path := writeTestImage(t, "thumbnail.png", 1280, 720)
+
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/upload/youtube/v3/thumbnails/set", r.URL.Path)
+		assert.Equal(t, "abc", r.URL.Query().Get("videoId"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items": [{"default": {"url": "https://example.com/thumb.png"}}]}`))
+	})
+
+	require.NoError(t, SetThumbnail(context.Background(), service, "abc", path))
+	assert.Equal(t, int64(1), YouTubeMetrics.GetThumbnailSetSuccess())
+	assert.Equal(t, int64(0), YouTubeMetrics.GetThumbnailSetFailure())
+}
+
+func TestSetThumbnail_APIError(t *testing.T) {
+	YouTubeMetrics.Reset()
+	path := writeTestImage(t, "thumbnail.jpg", 1280, 720)
+
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error": {"code": 429, "message": "slow down"}}`))
+	})
+
+	err := SetThumbnail(context.Background(), service, "abc", path)
+	require.Error(t, err)
+
+	var yErr *YouTubeError
+	require.True(t, errors.As(err, &yErr))
+	assert.Equal(t, ErrorTypeRateLimit, yErr.Type)
+	assert.Equal(t, int64(1), YouTubeMetrics.GetThumbnailSetFailure())
+}
+
+func TestSetThumbnail_RejectsInvalidFiles(t *testing.T) {
+	calls := 0
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	})
+
+	tests := []struct {
+		name          string
+		path          string
+		errorContains string
+	}{
+		{name: "Oversize file", path: writeTestThumbnail(t, "big.jpg", MaxThumbnailBytes+1), errorContains: "exceeding"},
+		{name: "Unsupported type", path: writeTestThumbnail(t, "thumbnail.gif", 1024), errorContains: "unsupported type"},
+		{name: "Missing file", path: filepath.Join(t.TempDir(), "missing.png"), errorContains: "not accessible"},
+		{name: "Undersized image", path: writeTestImage(t, "small.png", 320, 180), errorContains: "narrower than the minimum width"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			YouTubeMetrics.Reset()
+			err := SetThumbnail(context.Background(), service, "abc", tt.path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorContains)
+			assert.Equal(t, int64(1), YouTubeMetrics.GetThumbnailSetFailure())
+		})
+	}
+	assert.Equal(t, 0, calls, "invalid thumbnails must not reach the API")
+}
+
+func TestSetThumbnail_AcceptsLimitSize(t *testing.T) {
+	_, err := validateThumbnailFile(writeTestThumbnail(t, "exact.JPEG", MaxThumbnailBytes))
+	assert.NoError(t, err)
+}
+
+func TestValidateThumbnail(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		errorContains string
+	}{
+		{name: "Recommended png", path: writeTestImage(t, "thumbnail.png", 1280, 720)},
+		{name: "Full HD jpeg", path: writeTestImage(t, "thumbnail.jpg", 1920, 1080)},
+		{name: "Minimum width", path: writeTestImage(t, "thumbnail.png", 640, 360)},
+		{name: "Undersized", path: writeTestImage(t, "thumbnail.png", 320, 180), errorContains: "narrower than the minimum width of 640"},
+		{name: "Square", path: writeTestImage(t, "thumbnail.jpg", 1080, 1080), errorContains: "16:9 aspect ratio"},
+		{name: "Jpeg extension", path: writeTestImage(t, "thumbnail.jpeg", 1280, 720)},
+		{name: "Not an image", path: writeTestThumbnail(t, "thumbnail.png", 1024), errorContains: "not a readable"},
+		{name: "Unsupported type", path: writeTestThumbnail(t, "thumbnail.gif", 1024), errorContains: "unsupported type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateThumbnail(tt.path)
+			if tt.errorContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var yErr *YouTubeError
+			require.ErrorAs(t, err, &yErr)
+			assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+			assert.Contains(t, yErr.Message, tt.errorContains)
+		})
+	}
+}
+
+func TestValidateThumbnail_RejectsMismatchedContent(t *testing.T) {
+	pngPath := writeTestImage(t, "thumbnail.png", 1280, 720)
+	jpgPath := filepath.Join(t.TempDir(), "thumbnail.jpg")
+	require.NoError(t, os.Rename(pngPath, jpgPath))
+
+	err := ValidateThumbnail(jpgPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "doesn't match its extension")
+}
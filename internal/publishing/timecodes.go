@@ -0,0 +1,23 @@
+package publishing
+
+import (
+	"time"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+)
+
+// MinChapters is the number of chapters YouTube requires before it shows them on a video.
+const MinChapters = storage.MinChapters
+
+// Chapter is a single timecode entry marking where a section of the video starts.
+type Chapter = storage.Chapter
+
+// ParseTimecodes parses one chapter per line, see storage.ParseTimecodes.
+func ParseTimecodes(raw string) ([]Chapter, error) {
+	return storage.ParseTimecodes(raw)
+}
+
+// FormatTimecode formats a duration as "mm:ss", or "h:mm:ss" from one hour on.
+func FormatTimecode(d time.Duration) string {
+	return storage.FormatTimecode(d)
+}
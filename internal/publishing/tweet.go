@@ -0,0 +1,78 @@
+package publishing
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+)
+
+// DefaultTweetTemplate is the template GenerateTweet uses when none is given.
+const DefaultTweetTemplate = `{{.Title}}
+{{with .ProjectURL}}
+{{.}}
+{{end}}
+{{.YouTube}}
+
+{{.Hashtags}}`
+
+// tweetData is what tweet templates are executed with: the video's fields, such as .Title and
+// .ProjectURL, plus the ones below.
+type tweetData struct {
+	*storage.Video
+	Hashtags string // Tags formatted as space-separated hashtags
+	YouTube  string // The [YOUTUBE] placeholder replaced by the video link when posting
+}
+
+// GenerateTweet renders a tweet for the video from tmpl, a text/template executed with the
+// video's fields plus .Hashtags, its tags as hashtags, and .YouTube, the [YOUTUBE] link
+// placeholder. DefaultTweetTemplate is used when tmpl is empty. Surrounding whitespace is
+// trimmed, and a tweet longer than storage.MaxTweetLength, as X counts it, is cut on a word
+// boundary. It fails when the template is invalid or the cut would drop the link placeholder.
+func GenerateTweet(video *storage.Video, tmpl string) (string, error) {
+	if video == nil {
+		return "", fmt.Errorf("video metadata is required to generate a tweet")
+	}
+	if tmpl == "" {
+		tmpl = DefaultTweetTemplate
+	}
+	parsed, err := template.New("tweet").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("invalid tweet template: %w", err)
+	}
+
+	var tweet strings.Builder
+	data := tweetData{
+		Video:    video,
+		Hashtags: strings.Join(FormatHashtags(video.Tags), " "),
+		YouTube:  blueSkyLinkPlaceholder,
+	}
+	if err := parsed.Execute(&tweet, data); err != nil {
+		return "", fmt.Errorf("failed to generate tweet: %w", err)
+	}
+
+	text := strings.TrimSpace(tweet.String())
+	hasLink := strings.Contains(text, blueSkyLinkPlaceholder)
+	text = truncateTweet(text)
+	if hasLink && !strings.Contains(text, blueSkyLinkPlaceholder) {
+		return "", fmt.Errorf("tweet is too long to keep the %s link within %d characters", blueSkyLinkPlaceholder, storage.MaxTweetLength)
+	}
+	return text, nil
+}
+
+// truncateTweet drops trailing words from text until it fits in storage.MaxTweetLength.
+// A single word that doesn't fit is cut.
+func truncateTweet(text string) string {
+	for (storage.Video{Tweet: text}).TweetLength() > storage.MaxTweetLength {
+		cut := strings.LastIndexFunc(text, unicode.IsSpace)
+		if cut <= 0 {
+			runes := []rune(text)
+			text = string(runes[:len(runes)-1])
+			continue
+		}
+		text = strings.TrimRightFunc(text[:cut], unicode.IsSpace)
+	}
+	return text
+}
@@ -0,0 +1,73 @@
+package publishing
+
+import (
+	"strings"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTweet_DefaultTemplate(t *testing.T) {
+	video := &storage.Video{
+		Title:      "Crossplane in 10 Minutes",
+		ProjectURL: "https://crossplane.io",
+		Tags:       "crossplane, kubernetes, #devops",
+	}
+
+	tweet, err := GenerateTweet(video, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Crossplane in 10 Minutes\n\nhttps://crossplane.io\n\n[YOUTUBE]\n\n#crossplane #kubernetes #devops", tweet)
+}
+
+func TestGenerateTweet_DefaultTemplateWithEmptyFields(t *testing.T) {
+	tweet, err := GenerateTweet(&storage.Video{Title: "Crossplane in 10 Minutes"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Crossplane in 10 Minutes\n\n[YOUTUBE]", tweet)
+}
+
+func TestGenerateTweet_CustomTemplate(t *testing.T) {
+	video := &storage.Video{Title: "Crossplane", ProjectName: "Crossplane", Tags: "crossplane"}
+
+	tweet, err := GenerateTweet(video, "New video about {{.ProjectName}}! {{.YouTube}} {{.Hashtags}}")
+	require.NoError(t, err)
+	assert.Equal(t, "New video about Crossplane! [YOUTUBE] #crossplane", tweet)
+}
+
+func TestGenerateTweet_LengthEnforcement(t *testing.T) {
+	video := &storage.Video{
+		Title:      strings.TrimSpace(strings.Repeat("word ", 40)),
+		ProjectURL: "https://example.com/" + strings.Repeat("long-path/", 20),
+		Tags:       strings.Repeat("kubernetes, ", 20) + "crossplane, argocd, flux, backstage, knative, dapr",
+	}
+
+	tweet, err := GenerateTweet(video, "")
+	require.NoError(t, err)
+	assert.LessOrEqual(t, storage.Video{Tweet: tweet}.TweetLength(), storage.MaxTweetLength)
+	assert.Contains(t, tweet, "[YOUTUBE]")
+	assert.Contains(t, tweet, video.ProjectURL, "links count as 23 characters however long they are")
+	assert.True(t, strings.HasSuffix(tweet, "[YOUTUBE]\n\n#kubernetes #crossplane"), "the tweet is cut on a word boundary: %q", tweet)
+}
+
+func TestGenerateTweet_LengthEnforcementKeepsLink(t *testing.T) {
+	video := &storage.Video{Title: strings.Repeat("a", 300)}
+
+	_, err := GenerateTweet(video, "")
+	assert.ErrorContains(t, err, "too long to keep the [YOUTUBE] link")
+
+	tweet, err := GenerateTweet(video, "{{.Title}}")
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("a", storage.MaxTweetLength), tweet, "a single word is cut to fit")
+}
+
+func TestGenerateTweet_Errors(t *testing.T) {
+	_, err := GenerateTweet(nil, "")
+	assert.Error(t, err)
+
+	_, err = GenerateTweet(&storage.Video{}, "{{.Title")
+	assert.ErrorContains(t, err, "invalid tweet template")
+
+	_, err = GenerateTweet(&storage.Video{}, "{{.Unknown}}")
+	assert.ErrorContains(t, err, "failed to generate tweet")
+}
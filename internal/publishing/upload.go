@@ -0,0 +1,184 @@
+package publishing
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"google.golang.org/api/youtube/v3"
+)
+
+// PublishOptions controls how UploadVideo sends and records a video.
+type PublishOptions struct {
+	Store    storage.Store // Saves the video with its new ID to Path; nothing is saved when nil
+	Path     string        // Path of the video's metadata, video.Path when empty
+	Progress ProgressFunc  // Receives upload progress, UploadProgress when nil
+	// StrictLanguage fails the upload with a language error, before anything is sent, when the
+	// video's language or audio language code is invalid, instead of falling back to the default
+	StrictLanguage bool
+	// Config overrides the package-level defaults; it is validated before anything is sent.
+	// Its Uploader sends the file resumably, and its Retry policy applies to it, where
+	// Uploader.MaxAttempts takes precedence.
+	Config *PublishConfig
+}
+
+// UploadVideo uploads the video file at video.UploadVideo as a new YouTube video described by
+// the video metadata, sets video.VideoId to the created ID and, when opts.Store is set, saves
+// the video. Failures are returned as a *YouTubeError and counted in YouTubeMetrics. When the
+// upload succeeded but saving failed, the new ID is returned along with the error so it isn't lost.
+func UploadVideo(ctx context.Context, service *youtube.Service, video *storage.Video, opts PublishOptions) (string, error) {
+	if video == nil {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "video metadata is required to upload a video"}
+	}
+	if err := video.ValidateTitle(); err != nil {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: err.Error()}
+	}
+	if err := ValidateVideoFile(video.UploadVideo); err != nil {
+		return "", err
+	}
+	if opts.Config != nil {
+		if err := opts.Config.Validate(); err != nil {
+			return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "invalid publish config", OriginalError: err}
+		}
+	}
+	CheckLanguageConsistency(video, opts.Config.captionLanguages())
+	if skipIfDryRun(opts.Config.dryRun(), "upload %s to YouTube as %q", video.UploadVideo, video.Title) {
+		return DryRunVideoID, nil
+	}
+	upload, err := buildVideoUpload(video, opts.Config, opts.StrictLanguage)
+	if err != nil {
+		return "", err
+	}
+	done, err := trackOperation()
+	if err != nil {
+		return "", err
+	}
+	defer done()
+
+	progress := opts.Progress
+	if progress == nil {
+		progress = UploadProgress
+	}
+	progress = countUploadedBytes(progress)
+
+	var response *youtube.Video
+	if uploader := opts.Config.uploader(); uploader != nil {
+		response, err = uploader.upload(ctx, upload, video.UploadVideo, progress, opts.Config.retryPolicy())
+	} else {
+		response, err = insertVideoFile(ctx, service, upload, video.UploadVideo, progress)
+	}
+	if err := recordUploadOutcome(video, response, err); err != nil {
+		return "", err
+	}
+
+	if opts.Store != nil {
+		path := opts.Path
+		if path == "" {
+			path = video.Path
+		}
+		if err := opts.Store.WriteVideo(*video, path); err != nil {
+			return response.Id, fmt.Errorf("video uploaded as %s but saving its ID failed: %w", response.Id, err)
+		}
+	}
+	return response.Id, nil
+}
+
+// UploadVideoReader works like UploadVideo but uploads the video read from r, such as the
+// output of a pipe, instead of a file on disk. size is the length of the stream, used to report
+// progress to UploadProgress. The stream must start with an mp4, mov, mkv or webm header, and
+// video.VideoId is set to the created ID. config, which may be nil, works like PublishOptions.Config.
+// Uploads through config's Uploader buffer the stream to a temporary file so they can be
+// resumed after an error.
+func UploadVideoReader(ctx context.Context, service *youtube.Service, r io.Reader, size int64, video *storage.Video, config *PublishConfig) (string, error) {
+	if video == nil {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "video metadata is required to upload a video"}
+	}
+	if err := video.ValidateTitle(); err != nil {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: err.Error()}
+	}
+	if r == nil {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "video stream is required to upload a video"}
+	}
+	if config != nil {
+		if err := config.Validate(); err != nil {
+			return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "invalid publish config", OriginalError: err}
+		}
+	}
+	CheckLanguageConsistency(video, config.captionLanguages())
+	if skipIfDryRun(config.dryRun(), "upload a %d byte stream to YouTube as %q", size, video.Title) {
+		return DryRunVideoID, nil
+	}
+	if service == nil {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "youtube service is required to upload a video"}
+	}
+
+	media := bufio.NewReader(r)
+	header, err := media.Peek(12)
+	if err != nil && err != io.EOF {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "video stream is not readable", OriginalError: err}
+	}
+	if len(header) == 0 {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "video stream is empty"}
+	}
+	if problem := sniffVideoContainer(header); problem != "" {
+		return "", &YouTubeError{Type: ErrorTypeInvalid, Message: "video stream " + problem}
+	}
+	upload, err := buildVideoUpload(video, config, false)
+	if err != nil {
+		return "", err
+	}
+	done, err := trackOperation()
+	if err != nil {
+		return "", err
+	}
+	defer done()
+
+	var response *youtube.Video
+	if uploader := config.uploader(); uploader != nil {
+		response, err = uploader.uploadStream(ctx, upload, media, countUploadedBytes(UploadProgress), config.retryPolicy())
+	} else {
+		response, err = insertVideo(ctx, service, upload, media, size, countUploadedBytes(UploadProgress))
+	}
+	if err := recordUploadOutcome(video, response, err); err != nil {
+		return "", err
+	}
+	return response.Id, nil
+}
+
+// recordUploadOutcome logs, counts and audits the result of an upload, setting video.VideoId
+// when it succeeded. Failures are returned as a *YouTubeError.
+func recordUploadOutcome(video *storage.Video, response *youtube.Video, err error) error {
+	if err != nil {
+		yErr := CategorizeError(err)
+		LogYouTubeError(yErr, "YouTube API upload failed")
+		YouTubeMetrics.IncUploadFailure()
+		audit(AuditOperationUpload, "", yErr)
+		return yErr
+	}
+
+	LogUploadOperation(response.Id, true, nil)
+	YouTubeMetrics.IncUploadSuccess()
+	audit(AuditOperationUpload, response.Id, nil)
+	video.VideoId = response.Id
+	return nil
+}
+
+// insertVideoFile uploads the file at path through the service.
+func insertVideoFile(ctx context.Context, service *youtube.Service, upload *youtube.Video, path string, progress ProgressFunc) (*youtube.Video, error) {
+	if service == nil {
+		return nil, &YouTubeError{Type: ErrorTypeInvalid, Message: "youtube service is required to upload a video"}
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, NewUploadError("", fmt.Errorf("failed to open video file %s: %w", path, err))
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, NewUploadError("", fmt.Errorf("failed to read video file %s: %w", path, err))
+	}
+	return insertVideo(ctx, service, upload, file, info.Size(), progress)
+}
@@ -0,0 +1,83 @@
+package publishing
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+// ProgressFunc receives upload progress: the bytes sent so far and the size of the upload.
+type ProgressFunc func(bytesSent, totalBytes int64)
+
+// UploadProgress, when set, is called while UploadVideo sends the video file, at most once per
+// progressInterval and once more when the upload completes.
+var UploadProgress ProgressFunc
+
+// Upload tuning, replaceable for testing
+var (
+	progressInterval = 500 * time.Millisecond
+	uploadChunkSize  = googleapi.DefaultUploadChunkSize
+)
+
+// insertVideo uploads media as a new video. Large files are sent in chunks, and the given
+// callback, if any, is throttled and reported the bytes confirmed by YouTube after each chunk.
+func insertVideo(ctx context.Context, service *youtube.Service, upload *youtube.Video, media io.Reader, size int64, progress ProgressFunc) (*youtube.Video, error) {
+	call := service.Videos.Insert([]string{"snippet", "status"}, upload).
+		Media(media, googleapi.ChunkSize(uploadChunkSize)).
+		Context(ctx)
+
+	var reporter *progressReporter
+	if progress != nil {
+		reporter = &progressReporter{report: progress, total: size}
+		call = call.ProgressUpdater(func(current, _ int64) {
+			reporter.update(current, false)
+		})
+	}
+
+	response, err := call.Do()
+	if err == nil && reporter != nil {
+		reporter.update(size, true)
+	}
+	return response, err
+}
+
+// countUploadedBytes wraps progress, which may be nil, so that the size of the upload is added
+// to YouTubeMetrics once its final progress report, with all bytes sent, arrives.
+func countUploadedBytes(progress ProgressFunc) ProgressFunc {
+	var once sync.Once
+	return func(bytesSent, totalBytes int64) {
+		if bytesSent >= totalBytes {
+			once.Do(func() { YouTubeMetrics.AddBytesUploaded(totalBytes) })
+		}
+		if progress != nil {
+			progress(bytesSent, totalBytes)
+		}
+	}
+}
+
+// progressReporter forwards progress updates to a ProgressFunc at most once per progressInterval.
+type progressReporter struct {
+	mu       sync.Mutex
+	report   ProgressFunc
+	total    int64
+	last     time.Time
+	reported bool
+}
+
+// update reports current unless the previous report was too recent. Forced updates, used for
+// the final 100% report, are always sent.
+func (p *progressReporter) update(current int64, force bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := clock.Now()
+	if !force && p.reported && now.Sub(p.last) < progressInterval {
+		return
+	}
+	p.last, p.reported = now, true
+	p.report(current, p.total)
+}
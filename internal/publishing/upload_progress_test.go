@@ -0,0 +1,99 @@
+package publishing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+// zeroReader is a fake video stream producing an endless run of zero bytes.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+// setUploadTuning overrides the chunk size and progress interval for the duration of a test.
+func setUploadTuning(t *testing.T, chunkSize int, interval time.Duration) {
+	t.Helper()
+	originalChunk, originalInterval := uploadChunkSize, progressInterval
+	uploadChunkSize, progressInterval = chunkSize, interval
+	t.Cleanup(func() {
+		uploadChunkSize, progressInterval = originalChunk, originalInterval
+	})
+}
+
+// resumableUploadHandler emulates YouTube's resumable upload protocol: the initial request
+// returns a session URI and every chunk but the last is acknowledged as incomplete.
+func resumableUploadHandler(sessionURL func() string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		switch {
+		case r.URL.Query().Get("uploadType") == "resumable":
+			w.Header().Set("Location", sessionURL())
+		case strings.HasSuffix(r.Header.Get("Content-Range"), "/*"):
+			w.Header().Set("X-Http-Status-Code-Override", "308")
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id": "new-video-id"}`))
+		}
+	}
+}
+
+func TestInsertVideo_ReportsProgress(t *testing.T) {
+	setUploadTuning(t, googleapi.MinUploadChunkSize, 0)
+
+	var serverURL string
+	service := newTestYouTubeService(t, resumableUploadHandler(func() string { return serverURL + "/upload-session" }))
+	serverURL = strings.TrimSuffix(service.BasePath, "/")
+
+	size := int64(3*googleapi.MinUploadChunkSize + 100)
+	var mu sync.Mutex
+	var sent []int64
+	progress := func(bytesSent, totalBytes int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, size, totalBytes)
+		sent = append(sent, bytesSent)
+	}
+
+	upload := &youtube.Video{Snippet: &youtube.VideoSnippet{Title: "Title"}}
+	response, err := insertVideo(context.Background(), service, upload, io.LimitReader(zeroReader{}, size), size, progress)
+	require.NoError(t, err)
+	assert.Equal(t, "new-video-id", response.Id)
+
+	require.GreaterOrEqual(t, len(sent), 3)
+	for i := 1; i < len(sent); i++ {
+		assert.GreaterOrEqual(t, sent[i], sent[i-1], "progress must not go backwards")
+	}
+	assert.Less(t, sent[0], size)
+	assert.Equal(t, size, sent[len(sent)-1], "the last report must be at 100%")
+}
+
+func TestProgressReporter_Throttles(t *testing.T) {
+	fake := useFakeClock(t)
+	setUploadTuning(t, uploadChunkSize, 500*time.Millisecond)
+
+	var sent []int64
+	reporter := &progressReporter{report: func(bytesSent, totalBytes int64) {
+		sent = append(sent, bytesSent)
+	}, total: 100}
+
+	reporter.update(10, false)
+	reporter.update(20, false) // too soon, dropped
+	fake.Sleep(500 * time.Millisecond)
+	reporter.update(30, false)
+	reporter.update(100, true) // final report is never dropped
+
+	assert.Equal(t, []int64{10, 30, 100}, sent)
+}
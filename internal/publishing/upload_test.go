@@ -0,0 +1,310 @@
+package publishing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/youtube/v3"
+)
+
+// decodeUploadMetadata reads the video metadata part of a multipart upload request.
+func decodeUploadMetadata(t *testing.T, r *http.Request) youtube.Video {
+	t.Helper()
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	part, err := multipart.NewReader(r.Body, params["boundary"]).NextPart()
+	require.NoError(t, err)
+
+	var metadata youtube.Video
+	require.NoError(t, json.NewDecoder(part).Decode(&metadata))
+	return metadata
+}
+
+func TestUploadVideo_Success(t *testing.T) {
+	YouTubeMetrics.Reset()
+	var metadata youtube.Video
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "multipart", r.URL.Query().Get("uploadType"))
+		metadata = decodeUploadMetadata(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "new-video-id"}`))
+	})
+
+	video := &storage.Video{Name: "video", Title: "Title", Category: "Education", UploadVideo: writeTestVideoFile(t, 100)}
+	store := storage.NewMemoryStore()
+
+	videoID, err := UploadVideo(context.Background(), service, video, PublishOptions{Store: store, Path: "video.yaml"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "new-video-id", videoID)
+	assert.Equal(t, "new-video-id", video.VideoId)
+	assert.Equal(t, "Title", metadata.Snippet.Title)
+	assert.Equal(t, "27", metadata.Snippet.CategoryId)
+	assert.Equal(t, int64(1), YouTubeMetrics.GetUploadSuccess())
+	assert.Equal(t, int64(100), YouTubeMetrics.GetBytesUploaded())
+
+	saved, err := store.GetVideo("video.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "new-video-id", saved.VideoId)
+}
+
+func TestUploadVideo_RetryableFailure(t *testing.T) {
+	YouTubeMetrics.Reset()
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error": {"code": 503, "message": "backend unavailable"}}`))
+	})
+
+	store := storage.NewMemoryStore()
+	video := &storage.Video{Name: "video", Title: "Title", UploadVideo: writeTestVideoFile(t, 100)}
+
+	videoID, err := UploadVideo(context.Background(), service, video, PublishOptions{Store: store, Path: "video.yaml"})
+	require.Error(t, err)
+	assert.Zero(t, YouTubeMetrics.GetBytesUploaded(), "failed uploads must not count bytes")
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeServer, yErr.Type)
+	assert.True(t, yErr.Retryable)
+	assert.Empty(t, videoID)
+	assert.Empty(t, video.VideoId)
+	assert.Equal(t, int64(1), YouTubeMetrics.GetUploadFailure())
+	_, err = store.GetVideo("video.yaml")
+	assert.Error(t, err, "nothing is saved when the upload fails")
+}
+
+func TestUploadVideo_InvalidVideoFile(t *testing.T) {
+	YouTubeMetrics.Reset()
+	service := newTestYouTubeService(t, failOnRequest(t))
+
+	_, err := UploadVideo(context.Background(), service, &storage.Video{UploadVideo: "missing.mp4"}, PublishOptions{})
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+	assert.Zero(t, YouTubeMetrics.GetUploadTotal(), "a video that was never sent is not an upload attempt")
+}
+
+func TestUploadVideo_InvalidTitle(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+	video := &storage.Video{Title: "List<T> Explained", UploadVideo: writeTestVideoFile(t, 100)}
+
+	_, err := UploadVideo(context.Background(), service, video, PublishOptions{})
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+	assert.Contains(t, yErr.Message, "angle brackets")
+}
+
+func TestUploadVideo_DescriptionTags(t *testing.T) {
+	var metadata youtube.Video
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		metadata = decodeUploadMetadata(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "new-video-id"}`))
+	})
+	video := &storage.Video{Title: "Title", Description: "About Kubernetes", DescriptionTags: "kubernetes, gitops", UploadVideo: writeTestVideoFile(t, 100)}
+
+	_, err := UploadVideo(context.Background(), service, video, PublishOptions{})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(metadata.Snippet.Description, "About Kubernetes\n\n#kubernetes #gitops\n"),
+		"description should start with the text and its hashtags, got %q", metadata.Snippet.Description)
+}
+
+func TestUploadVideo_DescriptionChapters(t *testing.T) {
+	tests := []struct {
+		name      string
+		timecodes string
+		expected  string
+		rawBlock  bool
+	}{
+		{
+			name:      "Valid timecodes become chapters",
+			timecodes: "00:00 Intro\n01:30 Setup\n05:00 Wrap-up",
+			expected:  "About Kubernetes\n\n00:00 Intro\n01:30 Setup\n05:00 Wrap-up\n\n#kubernetes\n",
+		},
+		{
+			name:      "Invalid timecodes are uploaded without chapters",
+			timecodes: "00:10 Intro\n01:00 End",
+			expected:  "About Kubernetes\n\n#kubernetes\n",
+			rawBlock:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var metadata youtube.Video
+			service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+				metadata = decodeUploadMetadata(t, r)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id": "new-video-id"}`))
+			})
+			video := &storage.Video{Title: "Title", Description: "About Kubernetes", DescriptionTags: "kubernetes", Timecodes: tt.timecodes, UploadVideo: writeTestVideoFile(t, 100)}
+
+			_, err := UploadVideo(context.Background(), service, video, PublishOptions{})
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(metadata.Snippet.Description, tt.expected),
+				"description should start with %q, got %q", tt.expected, metadata.Snippet.Description)
+			assert.Equal(t, tt.rawBlock, strings.Contains(metadata.Snippet.Description, "⏱ Timecodes ⏱"),
+				"timecodes should only be listed separately when they aren't chapters")
+		})
+	}
+}
+
+func TestUploadVideo_DescriptionTooLong(t *testing.T) {
+	YouTubeMetrics.Reset()
+	service := newTestYouTubeService(t, failOnRequest(t))
+	video := &storage.Video{Title: "Title", Description: strings.Repeat("a", MaxDescriptionLength+1), UploadVideo: writeTestVideoFile(t, 100)}
+
+	_, err := UploadVideo(context.Background(), service, video, PublishOptions{})
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+	assert.Zero(t, YouTubeMetrics.GetUploadTotal(), "a video that was never sent is not an upload attempt")
+}
+
+func TestUploadVideo_DescriptionTemplateCountsTowardsLimit(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+	video := &storage.Video{Title: "Title", Description: strings.Repeat("a", MaxDescriptionLength-10), UploadVideo: writeTestVideoFile(t, 100)}
+
+	_, err := UploadVideo(context.Background(), service, video, PublishOptions{})
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr, "a description that only fits without the channel template must be rejected")
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+}
+
+func TestUploadVideo_DescriptionFitsWithTemplate(t *testing.T) {
+	var metadata youtube.Video
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		metadata = decodeUploadMetadata(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "new-video-id"}`))
+	})
+	template := utf8.RuneCountInString(assembleDescription("", GetAdditionalInfo("", "", "", ""), ""))
+	room := MaxDescriptionLength - template - len("\n\n#kubernetes")
+	video := &storage.Video{Title: "Title", Description: strings.Repeat("a", room), DescriptionTags: "kubernetes, gitops", UploadVideo: writeTestVideoFile(t, 100)}
+
+	_, err := UploadVideo(context.Background(), service, video, PublishOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, MaxDescriptionLength, utf8.RuneCountInString(metadata.Snippet.Description), "the whole description must fit the limit")
+	assert.Contains(t, metadata.Snippet.Description, "#kubernetes")
+	assert.NotContains(t, metadata.Snippet.Description, "#gitops", "hashtags that don't fit with the template are dropped")
+}
+
+func TestUploadVideo_StrictLanguage(t *testing.T) {
+	YouTubeMetrics.Reset()
+	service := newTestYouTubeService(t, failOnRequest(t))
+	video := &storage.Video{Title: "Title", Language: "klingon", UploadVideo: writeTestVideoFile(t, 100)}
+
+	_, err := UploadVideo(context.Background(), service, video, PublishOptions{StrictLanguage: true})
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeLanguage, yErr.Type)
+	assert.Zero(t, YouTubeMetrics.GetUploadTotal(), "a video that was never sent is not an upload attempt")
+}
+
+func TestUploadVideo_LenientLanguage(t *testing.T) {
+	var metadata youtube.Video
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		metadata = decodeUploadMetadata(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "new-video-id"}`))
+	})
+	useDefaultLanguage(t, "en")
+	video := &storage.Video{Title: "Title", Language: "klingon", UploadVideo: writeTestVideoFile(t, 100)}
+
+	videoID, err := UploadVideo(context.Background(), service, video, PublishOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "new-video-id", videoID)
+	assert.Equal(t, "en", metadata.Snippet.DefaultLanguage)
+}
+
+func TestUploadVideo_SaveFailure(t *testing.T) {
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "new-video-id"}`))
+	})
+	store := storage.NewMemoryStore()
+	store.FailNextWrite = errors.New("disk full")
+	video := &storage.Video{Name: "video", Title: "Title", UploadVideo: writeTestVideoFile(t, 100)}
+
+	videoID, err := UploadVideo(context.Background(), service, video, PublishOptions{Store: store, Path: "video.yaml"})
+	assert.ErrorContains(t, err, "video uploaded as new-video-id but saving its ID failed")
+	assert.Equal(t, "new-video-id", videoID, "the ID must not be lost when saving fails")
+}
+
+func TestUploadVideoReader(t *testing.T) {
+	YouTubeMetrics.Reset()
+	content := append(append([]byte{}, mp4Header...), "streamed frames"...)
+	var metadata youtube.Video
+	var media []byte
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		parts := multipart.NewReader(r.Body, params["boundary"])
+		part, err := parts.NextPart()
+		require.NoError(t, err)
+		require.NoError(t, json.NewDecoder(part).Decode(&metadata))
+		part, err = parts.NextPart()
+		require.NoError(t, err)
+		media, err = io.ReadAll(part)
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "new-video-id"}`))
+	})
+	video := &storage.Video{Title: "Title", Category: "Education", Tags: "Kubernetes, GitOps", Language: "es"}
+
+	videoID, err := UploadVideoReader(context.Background(), service, bytes.NewReader(content), int64(len(content)), video, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "new-video-id", videoID)
+	assert.Equal(t, "new-video-id", video.VideoId)
+	assert.Equal(t, content, media, "the stream must be uploaded unchanged")
+	assert.Equal(t, "Title", metadata.Snippet.Title)
+	assert.Equal(t, "27", metadata.Snippet.CategoryId)
+	assert.Equal(t, []string{"Kubernetes", "GitOps"}, metadata.Snippet.Tags)
+	assert.Equal(t, "es", metadata.Snippet.DefaultLanguage)
+	assert.Equal(t, int64(1), YouTubeMetrics.GetUploadSuccess())
+}
+
+func TestUploadVideoReader_RejectsInvalidStreams(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+
+	tests := []struct {
+		name        string
+		stream      io.Reader
+		errContains string
+	}{
+		{name: "Missing stream", stream: nil, errContains: "video stream is required"},
+		{name: "Empty stream", stream: bytes.NewReader(nil), errContains: "video stream is empty"},
+		{name: "Audio stream", stream: bytes.NewReader(mp3Header), errContains: "doesn't match an mp4, mov, mkv or webm container"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UploadVideoReader(context.Background(), service, tt.stream, 100, &storage.Video{Title: "Title"}, nil)
+
+			var yErr *YouTubeError
+			require.ErrorAs(t, err, &yErr)
+			assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+			assert.Contains(t, yErr.Message, tt.errContains)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package publishing
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// videoFileExtensions lists the container formats accepted for upload.
+var videoFileExtensions = map[string]bool{
+	".mp4":  true,
+	".mov":  true,
+	".mkv":  true,
+	".webm": true,
+}
+
+// ebmlMagic starts every Matroska file, mkv and webm alike.
+var ebmlMagic = []byte{0x1A, 0x45, 0xDF, 0xA3}
+
+// isoBoxTypes are the box types a mp4 or mov file can start with. Modern files begin with
+// "ftyp"; older QuickTime files may start directly with one of the others.
+var isoBoxTypes = map[string]bool{
+	"ftyp": true,
+	"moov": true,
+	"mdat": true,
+	"wide": true,
+	"free": true,
+	"skip": true,
+}
+
+// audioOnlyBrands are the ftyp major brands of audio files that share the mp4 container.
+var audioOnlyBrands = map[string]bool{
+	"M4A ": true,
+	"M4B ": true,
+	"M4P ": true,
+}
+
+// ValidateVideoFile checks that path is an existing, non-empty mp4, mov, mkv or webm file so
+// that uploads fail fast instead of deep inside the API. The content is checked too, since
+// renamed files, such as an mp3 saved as .mp4, would pass on their extension alone. Problems
+// are returned as an ErrorTypeInvalid *YouTubeError.
+func ValidateVideoFile(path string) error {
+	invalid := func(message string, err error) error {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: message, OriginalError: err}
+	}
+
+	if strings.TrimSpace(path) == "" {
+		return invalid("video file path is required", nil)
+	}
+	if !videoFileExtensions[strings.ToLower(filepath.Ext(path))] {
+		return invalid(fmt.Sprintf("video file %s has unsupported type, expected mp4, mov, mkv or webm", path), nil)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return invalid(fmt.Sprintf("video file %s is not accessible", path), err)
+	}
+	if info.IsDir() {
+		return invalid(fmt.Sprintf("video file %s is a directory", path), nil)
+	}
+	if info.Size() == 0 {
+		return invalid(fmt.Sprintf("video file %s is empty", path), nil)
+	}
+
+	header, err := readFileHeader(path, 12)
+	if err != nil {
+		return invalid(fmt.Sprintf("video file %s is not readable", path), err)
+	}
+	if problem := sniffVideoContainer(header); problem != "" {
+		return invalid(fmt.Sprintf("video file %s %s", path, problem), nil)
+	}
+	return nil
+}
+
+// readFileHeader returns up to n bytes from the start of the file at path.
+func readFileHeader(path string, n int) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	header := make([]byte, n)
+	read, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	return header[:read], nil
+}
+
+// sniffVideoContainer describes why header doesn't start a supported video container, or
+// returns "" when it does.
+func sniffVideoContainer(header []byte) string {
+	if bytes.HasPrefix(header, ebmlMagic) {
+		return ""
+	}
+	if len(header) >= 8 && isoBoxTypes[string(header[4:8])] {
+		if string(header[4:8]) == "ftyp" && len(header) >= 12 && audioOnlyBrands[string(header[8:12])] {
+			return fmt.Sprintf("is an audio-only file (brand %q), not a video", strings.TrimSpace(string(header[8:12])))
+		}
+		return ""
+	}
+	return "is not a video: its content doesn't match an mp4, mov, mkv or webm container"
+}
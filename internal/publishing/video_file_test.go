@@ -0,0 +1,73 @@
+package publishing
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Headers of the supported containers and of files that only look like videos by extension
+var (
+	mp4Header  = []byte{0x00, 0x00, 0x00, 0x18, 'f', 't', 'y', 'p', 'i', 's', 'o', 'm', 0x00, 0x00, 0x02, 0x00, 'i', 's', 'o', 'm', 'm', 'p', '4', '1'}
+	movHeader  = []byte{0x00, 0x00, 0x00, 0x14, 'f', 't', 'y', 'p', 'q', 't', ' ', ' ', 0x00, 0x00, 0x02, 0x00, 'q', 't', ' ', ' '}
+	webmHeader = []byte{0x1A, 0x45, 0xDF, 0xA3, 0x9F, 0x42, 0x86, 0x81, 0x01, 0x42, 0x82, 0x84, 'w', 'e', 'b', 'm'}
+	mp3Header  = []byte{'I', 'D', '3', 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xFF, 0xFB, 0x90, 0x00}
+	m4aHeader  = []byte{0x00, 0x00, 0x00, 0x20, 'f', 't', 'y', 'p', 'M', '4', 'A', ' ', 0x00, 0x00, 0x00, 0x00, 'M', '4', 'A', ' '}
+)
+
+func TestValidateVideoFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, content []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, content, 0644))
+		return path
+	}
+	valid := write("video.mp4", append(mp4Header, "frames"...))
+	upper := write("video.MOV", movHeader)
+	webm := write("video.webm", webmHeader)
+	empty := write("empty.mkv", nil)
+	text := write("video.txt", []byte("data"))
+	renamedMP3 := write("song.mp4", mp3Header)
+	renamedM4A := write("podcast.mp4", m4aHeader)
+	notVideo := write("notes.mkv", []byte("not really a video"))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "folder.mp4"), 0755))
+
+	tests := []struct {
+		name        string
+		path        string
+		errContains string
+	}{
+		{name: "Valid mp4", path: valid},
+		{name: "Upper-case extension", path: upper},
+		{name: "Valid webm", path: webm},
+		{name: "Missing file", path: filepath.Join(dir, "missing.mp4"), errContains: "is not accessible"},
+		{name: "Empty file", path: empty, errContains: "is empty"},
+		{name: "Unsupported extension", path: text, errContains: "expected mp4, mov, mkv or webm"},
+		{name: "Directory", path: filepath.Join(dir, "folder.mp4"), errContains: "is a directory"},
+		{name: "No path", path: " ", errContains: "path is required"},
+		{name: "Renamed mp3", path: renamedMP3, errContains: "doesn't match an mp4, mov, mkv or webm container"},
+		{name: "Renamed m4a", path: renamedM4A, errContains: "audio-only file (brand \"M4A\")"},
+		{name: "Text with a video extension", path: notVideo, errContains: "is not a video"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVideoFile(tt.path)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+
+			var yErr *YouTubeError
+			require.True(t, errors.As(err, &yErr))
+			assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+			assert.False(t, yErr.Retryable)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package publishing
+
+import "devopstoolkit/youtube-automation/internal/storage"
+
+// IsValidVideoID reports whether id looks like a YouTube video ID.
+// Surrounding whitespace is not tolerated, so callers should trim user input first.
+func IsValidVideoID(id string) bool {
+	return storage.IsValidVideoID(id)
+}
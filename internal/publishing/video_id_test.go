@@ -0,0 +1,30 @@
+package publishing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidVideoID(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		expected bool
+	}{
+		{name: "Valid ID", id: "dQw4w9WgXcQ", expected: true},
+		{name: "Valid ID with dash and underscore", id: "a-b_c-d_e-1", expected: true},
+		{name: "Trailing space", id: "dQw4w9WgXcQ ", expected: false},
+		{name: "Empty", id: "", expected: false},
+		{name: "Too short", id: "dQw4w9WgXc", expected: false},
+		{name: "Too long", id: "dQw4w9WgXcQQ", expected: false},
+		{name: "Illegal character", id: "dQw4w9WgX+Q", expected: false},
+		{name: "Full URL", id: "https://youtu.be/dQw4w9WgXcQ", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsValidVideoID(tt.id))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/youtube/v3"
+)
+
+// VideoStats holds the public engagement counts of a video.
+type VideoStats struct {
+	Views    uint64
+	Likes    uint64
+	Comments uint64
+}
+
+// GetVideoStats fetches the current view, like and comment counts of the video. Failures,
+// including a video that doesn't exist, are returned as a *YouTubeError.
+func GetVideoStats(ctx context.Context, service *youtube.Service, videoID string) (*VideoStats, error) {
+	if !IsValidVideoID(videoID) {
+		return nil, &YouTubeError{Type: ErrorTypeInvalid, Message: fmt.Sprintf("invalid video ID '%s'", videoID), VideoID: videoID}
+	}
+	if service == nil {
+		return nil, &YouTubeError{Type: ErrorTypeInvalid, Message: "youtube service is required to fetch video statistics", VideoID: videoID}
+	}
+
+	response, err := service.Videos.List([]string{"statistics"}).Id(videoID).Context(ctx).Do()
+	if err != nil {
+		yErr := CategorizeError(err)
+		yErr.VideoID = videoID
+		return nil, yErr
+	}
+	if len(response.Items) == 0 {
+		return nil, &YouTubeError{Type: ErrorTypeInvalid, Message: fmt.Sprintf("video %s not found", videoID), VideoID: videoID}
+	}
+
+	stats := response.Items[0].Statistics
+	if stats == nil {
+		return &VideoStats{}, nil
+	}
+	return &VideoStats{
+		Views:    stats.ViewCount,
+		Likes:    stats.LikeCount,
+		Comments: stats.CommentCount,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package publishing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVideoStats(t *testing.T) {
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "statistics", r.URL.Query().Get("part"))
+		assert.Equal(t, "dQw4w9WgXcQ", r.URL.Query().Get("id"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items": [{"id": "dQw4w9WgXcQ", "statistics": {"viewCount": "1500", "likeCount": "120", "commentCount": "33"}}]}`))
+	})
+
+	stats, err := GetVideoStats(context.Background(), service, "dQw4w9WgXcQ")
+	require.NoError(t, err)
+	assert.Equal(t, &VideoStats{Views: 1500, Likes: 120, Comments: 33}, stats)
+}
+
+func TestGetVideoStats_NotFound(t *testing.T) {
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items": []}`))
+	})
+
+	stats, err := GetVideoStats(context.Background(), service, "dQw4w9WgXcQ")
+	assert.Nil(t, stats)
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+	assert.Contains(t, yErr.Message, "not found")
+}
+
+func TestGetVideoStats_APIError(t *testing.T) {
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error": {"code": 503, "message": "backend unavailable"}}`))
+	})
+
+	_, err := GetVideoStats(context.Background(), service, "dQw4w9WgXcQ")
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeServer, yErr.Type)
+	assert.Equal(t, "dQw4w9WgXcQ", yErr.VideoID)
+}
+
+func TestGetVideoStats_InvalidID(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+
+	_, err := GetVideoStats(context.Background(), service, "https://youtu.be/dQw4w9WgXcQ")
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeInvalid, yErr.Type)
+}
@@ -0,0 +1,154 @@
+package publishing
+
+import (
+	"context"
+	"fmt"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"google.golang.org/api/youtube/v3"
+)
+
+// UpdateVideoMetadata updates the title, description, tags, category and languages of the
+// already published video video.VideoId from the video metadata. The current snippet is
+// fetched first and only the fields we manage are replaced, since YouTube overwrites the whole
+// snippet on update; other parts of the video, such as its status, are left alone. Failures are
+// returned as a *YouTubeError.
+func UpdateVideoMetadata(ctx context.Context, service *youtube.Service, video *storage.Video) error {
+	if service == nil {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: "youtube service is required to update a video"}
+	}
+	if video == nil {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: "video metadata is required to update a video"}
+	}
+	if !IsValidVideoID(video.VideoId) {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: fmt.Sprintf("invalid video ID '%s'", video.VideoId), VideoID: video.VideoId}
+	}
+	if skipForDryRun("update metadata of video ID %s to title %q", video.VideoId, video.Title) {
+		return nil
+	}
+	done, err := trackOperation()
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	err = updateVideoMetadata(ctx, service, video)
+	if err != nil {
+		yErr := CategorizeError(err)
+		yErr.VideoID = video.VideoId
+		LogYouTubeError(yErr, "Failed to update video metadata")
+		YouTubeMetrics.IncMetadataUpdateFailure()
+		audit(AuditOperationUpdate, video.VideoId, yErr)
+		return yErr
+	}
+	YouTubeMetrics.IncMetadataUpdateSuccess()
+	audit(AuditOperationUpdate, video.VideoId, nil)
+	LogYouTubeInfo("Updated metadata of video ID %s", video.VideoId)
+	return nil
+}
+
+func updateVideoMetadata(ctx context.Context, service *youtube.Service, video *storage.Video) error {
+	response, err := service.Videos.List([]string{"snippet"}).Id(video.VideoId).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if len(response.Items) == 0 {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: fmt.Sprintf("video %s not found", video.VideoId)}
+	}
+	snippet := response.Items[0].Snippet
+	if snippet == nil {
+		snippet = &youtube.VideoSnippet{}
+	}
+
+	// The languages are only recorded as applied once YouTube accepted them
+	applied, appliedAudio := video.AppliedLanguage, video.AppliedAudioLanguage
+	desired, err := newVideoUpload(video)
+	if err != nil {
+		video.AppliedLanguage, video.AppliedAudioLanguage = applied, appliedAudio
+		return err
+	}
+	snippet.Title = desired.Snippet.Title
+	snippet.Description = desired.Snippet.Description
+	snippet.Tags = desired.Snippet.Tags
+	snippet.CategoryId = desired.Snippet.CategoryId
+	snippet.DefaultLanguage = desired.Snippet.DefaultLanguage
+	snippet.DefaultAudioLanguage = desired.Snippet.DefaultAudioLanguage
+
+	_, err = service.Videos.Update([]string{"snippet"}, &youtube.Video{Id: video.VideoId, Snippet: snippet}).Context(ctx).Do()
+	if err != nil {
+		video.AppliedLanguage, video.AppliedAudioLanguage = applied, appliedAudio
+	}
+	return err
+}
+
+// ApplyLanguageRemote sets the language and audio language of the already published video
+// videoID on YouTube, leaving the rest of its snippet as it is. The languages are taken from
+// the video metadata with the same validation and fallback to defaultLanguage as ApplyLanguage,
+// and recorded as applied on the video once YouTube accepted them. The calls are bound to ctx,
+// so a cancelled or expired context aborts the update. Failures are returned as a *YouTubeError.
+func ApplyLanguageRemote(ctx context.Context, service *youtube.Service, videoID string, video *storage.Video, defaultLanguage string) error {
+	if service == nil {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: "youtube service is required to update a video"}
+	}
+	if !IsValidVideoID(videoID) {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: fmt.Sprintf("invalid video ID '%s'", videoID), VideoID: videoID}
+	}
+	if video == nil {
+		// A missing video has no language preferences, so the defaults apply
+		video = &storage.Video{}
+	}
+	if skipForDryRun("set the languages of video ID %s", videoID) {
+		return nil
+	}
+	done, err := trackOperation()
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	err = applyLanguageRemote(ctx, service, videoID, video, defaultLanguage)
+	if err != nil {
+		yErr := CategorizeError(err)
+		yErr.VideoID = videoID
+		LogYouTubeError(yErr, "Failed to update video languages")
+		YouTubeMetrics.IncMetadataUpdateFailure()
+		audit(AuditOperationUpdate, videoID, yErr)
+		return yErr
+	}
+	YouTubeMetrics.IncMetadataUpdateSuccess()
+	audit(AuditOperationUpdate, videoID, nil)
+	LogYouTubeInfo("Updated languages of video ID %s to %s (audio %s)", videoID, video.AppliedLanguage, video.AppliedAudioLanguage)
+	return nil
+}
+
+func applyLanguageRemote(ctx context.Context, service *youtube.Service, videoID string, video *storage.Video, defaultLanguage string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	response, err := service.Videos.List([]string{"snippet"}).Id(videoID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if len(response.Items) == 0 {
+		return &YouTubeError{Type: ErrorTypeInvalid, Message: fmt.Sprintf("video %s not found", videoID)}
+	}
+	snippet := response.Items[0].Snippet
+	if snippet == nil {
+		snippet = &youtube.VideoSnippet{}
+	}
+
+	// The languages are only recorded as applied once YouTube accepted them
+	applied, appliedAudio := video.AppliedLanguage, video.AppliedAudioLanguage
+	result, err := ApplyLanguage(&youtube.Video{}, video, defaultLanguage)
+	if err != nil {
+		return err
+	}
+	snippet.DefaultLanguage = result.AppliedLanguage
+	snippet.DefaultAudioLanguage = result.AppliedAudio
+
+	_, err = service.Videos.Update([]string{"snippet"}, &youtube.Video{Id: videoID, Snippet: snippet}).Context(ctx).Do()
+	if err != nil {
+		video.AppliedLanguage, video.AppliedAudioLanguage = applied, appliedAudio
+	}
+	return err
+}
@@ -0,0 +1,204 @@
+package publishing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"devopstoolkit/youtube-automation/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/youtube/v3"
+)
+
+// existingVideoJSON is a published video as returned by videos.list, including snippet fields
+// that UpdateVideoMetadata doesn't manage.
+const existingVideoJSON = `{"items": [{"id": "dQw4w9WgXcQ", "snippet": {
+	"title": "Old title",
+	"description": "Old description",
+	"tags": ["old"],
+	"categoryId": "22",
+	"channelId": "channel",
+	"channelTitle": "DevOps Toolkit",
+	"publishedAt": "2025-01-01T00:00:00Z",
+	"defaultLanguage": "en",
+	"liveBroadcastContent": "none",
+	"localized": {"title": "Old title"}
+}}]}`
+
+func TestUpdateVideoMetadata(t *testing.T) {
+	YouTubeMetrics.Reset()
+	var updated youtube.Video
+	var part string
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "dQw4w9WgXcQ", r.URL.Query().Get("id"))
+			w.Write([]byte(existingVideoJSON))
+		case http.MethodPut:
+			part = r.URL.Query().Get("part")
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			json.NewEncoder(w).Encode(updated)
+		}
+	})
+
+	video := &storage.Video{
+		VideoId:     "dQw4w9WgXcQ",
+		Title:       "New title",
+		Description: "New description",
+		Tags:        "Kubernetes, GitOps",
+		Category:    "Education",
+		Language:    "es",
+	}
+	require.NoError(t, UpdateVideoMetadata(context.Background(), service, video))
+
+	assert.Equal(t, "snippet", part, "only the snippet may be updated")
+	assert.Equal(t, "dQw4w9WgXcQ", updated.Id)
+	require.NotNil(t, updated.Snippet)
+	assert.Equal(t, "New title", updated.Snippet.Title)
+	assert.Contains(t, updated.Snippet.Description, "New description")
+	assert.Equal(t, []string{"Kubernetes", "GitOps"}, updated.Snippet.Tags)
+	assert.Equal(t, "27", updated.Snippet.CategoryId)
+	assert.Equal(t, "es", updated.Snippet.DefaultLanguage)
+	assert.Equal(t, "es", video.AppliedLanguage)
+
+	// Fields we don't manage are sent back unchanged
+	assert.Equal(t, "channel", updated.Snippet.ChannelId)
+	assert.Equal(t, "DevOps Toolkit", updated.Snippet.ChannelTitle)
+	assert.Equal(t, "2025-01-01T00:00:00Z", updated.Snippet.PublishedAt)
+	assert.Equal(t, "none", updated.Snippet.LiveBroadcastContent)
+	require.NotNil(t, updated.Snippet.Localized)
+	assert.Equal(t, "Old title", updated.Snippet.Localized.Title)
+	assert.Nil(t, updated.Status, "the status must not be touched")
+
+	assert.Equal(t, int64(1), YouTubeMetrics.GetMetadataUpdateSuccess())
+}
+
+func TestUpdateVideoMetadata_Failure(t *testing.T) {
+	YouTubeMetrics.Reset()
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(existingVideoJSON))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"code": 403, "message": "forbidden", "errors": [{"reason": "forbidden"}]}}`))
+	})
+
+	video := &storage.Video{VideoId: "dQw4w9WgXcQ", Title: "New title", Language: "es", AppliedLanguage: "en"}
+	err := UpdateVideoMetadata(context.Background(), service, video)
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeAuth, yErr.Type)
+	assert.Equal(t, "dQw4w9WgXcQ", yErr.VideoID)
+	assert.Equal(t, "en", video.AppliedLanguage, "languages YouTube rejected are not recorded as applied")
+	assert.Equal(t, int64(1), YouTubeMetrics.GetMetadataUpdateFailure())
+}
+
+func TestUpdateVideoMetadata_NotFound(t *testing.T) {
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "nothing may be updated")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items": []}`))
+	})
+
+	err := UpdateVideoMetadata(context.Background(), service, &storage.Video{VideoId: "dQw4w9WgXcQ"})
+	assert.ErrorContains(t, err, "video dQw4w9WgXcQ not found")
+}
+
+func TestUpdateVideoMetadata_InvalidInput(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+
+	assert.Error(t, UpdateVideoMetadata(context.Background(), nil, &storage.Video{VideoId: "dQw4w9WgXcQ"}))
+	assert.Error(t, UpdateVideoMetadata(context.Background(), service, nil))
+	assert.Error(t, UpdateVideoMetadata(context.Background(), service, &storage.Video{VideoId: "bad id"}))
+}
+
+func TestApplyLanguageRemote(t *testing.T) {
+	YouTubeMetrics.Reset()
+	var updated youtube.Video
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(existingVideoJSON))
+		case http.MethodPut:
+			assert.Equal(t, "snippet", r.URL.Query().Get("part"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			json.NewEncoder(w).Encode(updated)
+		}
+	})
+
+	video := &storage.Video{Title: "New title", Language: "es", AudioLanguage: "klingon"}
+	require.NoError(t, ApplyLanguageRemote(context.Background(), service, "dQw4w9WgXcQ", video, "en"))
+
+	require.NotNil(t, updated.Snippet)
+	assert.Equal(t, "es", updated.Snippet.DefaultLanguage)
+	assert.Equal(t, "en", updated.Snippet.DefaultAudioLanguage, "invalid codes fall back to the default")
+	assert.Equal(t, "Old title", updated.Snippet.Title, "only the languages are changed")
+	assert.Equal(t, "Old description", updated.Snippet.Description)
+	assert.Equal(t, "22", updated.Snippet.CategoryId)
+	assert.Equal(t, "es", video.AppliedLanguage)
+	assert.Equal(t, "en", video.AppliedAudioLanguage)
+	assert.Equal(t, int64(1), YouTubeMetrics.GetMetadataUpdateSuccess())
+}
+
+func TestApplyLanguageRemote_Failure(t *testing.T) {
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(existingVideoJSON))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"code": 403, "message": "forbidden", "errors": [{"reason": "forbidden"}]}}`))
+	})
+
+	video := &storage.Video{Language: "es", AppliedLanguage: "en"}
+	err := ApplyLanguageRemote(context.Background(), service, "dQw4w9WgXcQ", video, "en")
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.Equal(t, ErrorTypeAuth, yErr.Type)
+	assert.Equal(t, "dQw4w9WgXcQ", yErr.VideoID)
+	assert.Equal(t, "en", video.AppliedLanguage, "languages YouTube rejected are not recorded as applied")
+}
+
+func TestApplyLanguageRemote_ContextCancelled(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	video := &storage.Video{Language: "es"}
+	err := ApplyLanguageRemote(ctx, service, "dQw4w9WgXcQ", video, "en")
+
+	var yErr *YouTubeError
+	require.ErrorAs(t, err, &yErr)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, video.AppliedLanguage)
+}
+
+func TestApplyLanguageRemote_ContextTimeout(t *testing.T) {
+	release := make(chan struct{})
+	service := newTestYouTubeService(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	})
+	t.Cleanup(func() { close(release) })
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := ApplyLanguageRemote(ctx, service, "dQw4w9WgXcQ", &storage.Video{Language: "es"}, "en")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestApplyLanguageRemote_InvalidInput(t *testing.T) {
+	service := newTestYouTubeService(t, failOnRequest(t))
+
+	assert.Error(t, ApplyLanguageRemote(context.Background(), nil, "dQw4w9WgXcQ", &storage.Video{}, "en"))
+	assert.Error(t, ApplyLanguageRemote(context.Background(), service, "bad id", &storage.Video{}, "en"))
+}
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"unicode/utf8"
 
 	"devopstoolkit/youtube-automation/internal/configuration"
 	"devopstoolkit/youtube-automation/internal/storage"
@@ -234,7 +235,11 @@ func saveToken(file string, token *oauth2.Token) {
 	json.NewEncoder(f).Encode(token)
 }
 
-func UploadVideo(video *storage.Video) string {
+// UploadVideoInteractive uploads the video with UploadVideo, authorizing through the
+// interactive OAuth flow. Interrupted uploads resume from a session saved next to the video
+// file. It returns the new video ID, or "" when the video file isn't ready for upload, and
+// exits the program on any other failure.
+func UploadVideoInteractive(video *storage.Video) string {
 	if video.UploadVideo == "" {
 		log.Fatalf("You must provide a filename of a video file to upload")
 		return ""
@@ -243,31 +248,37 @@ func UploadVideo(video *storage.Video) string {
 		log.Fatalf("You must provide a thumbnail of the video file to upload")
 		return ""
 	}
+	if err := ValidateVideoFile(video.UploadVideo); err != nil {
+		LogYouTubeError(CategorizeError(err), "Video file is not ready for upload")
+		return ""
+	}
+	if skipForDryRun("upload %s to YouTube as %q", video.UploadVideo, video.Title) {
+		return DryRunVideoID
+	}
 	client := getClient(context.Background(), &oauth2.Config{Scopes: []string{youtube.YoutubeUploadScope}})
-
-	// FIXME: Remove the comment
-	// service, err := youtube.New(client)
 	ctx := context.Background()
 	service, err := youtube.NewService(ctx, option.WithHTTPClient(client))
-	// service, err := youtube.NewService(ctx, option.WithHTTPClient(client))
 	if err != nil {
 		log.Fatalf("Error creating YouTube client: %v", err)
 	}
-	timecodes := ""
-	if len(video.Timecodes) > 0 && video.Timecodes != "N/A" {
-		timecodes = fmt.Sprintf("▬▬▬▬▬▬ ⏱ Timecodes ⏱ ▬▬▬▬▬▬\n%s", video.Timecodes)
-	}
-	
-	// Construct Hugo URL from title and category for video description
-	hugoURL := ""
-	if video.Title != "" && video.Gist != "" {
-		category := GetCategoryFromFilePath(video.Gist)
-		hugoURL = ConstructHugoURL(video.Title, category)
+
+	videoID, err := UploadVideo(ctx, service, video, PublishOptions{Config: &PublishConfig{Uploader: &ResumableUploader{Client: client}}})
+	if err != nil {
+		log.Fatalf("Error getting response from YouTube during insert: %v", err)
 	}
-	
-	description := fmt.Sprintf(`%s
+	fmt.Printf("Upload successful! Video ID: %v\n", videoID)
 
-%s
+	// Log language information
+	LogYouTubeInfo("Language %s and Audio Language %s applied to video ID %s", 
+		video.AppliedLanguage, video.AppliedAudioLanguage, videoID)
+
+	return videoID
+}
+
+// assembleDescription places the video's own description in the channel template, followed by
+// the additional info and the timecodes that aren't chapters.
+func assembleDescription(body, additionalInfo, timecodes string) string {
+	return fmt.Sprintf(`%s
 
 Consider joining the channel: https://www.youtube.com/c/devopstoolkit/join
 
@@ -285,18 +296,65 @@ If you are interested in sponsoring this channel, please visit https://devopstoo
 💬 Live streams: https://www.youtube.com/c/DevOpsParadox
 
 %s
-`, video.Description, video.DescriptionTags, GetAdditionalInfo(hugoURL, video.ProjectName, video.ProjectURL, video.RelatedVideos), timecodes)
+`, body, additionalInfo, timecodes)
+}
+
+// newVideoUpload builds the YouTube video to upload from the video metadata: the full
+// description, privacy, category, tags and language. It fails when the description is too long.
+func newVideoUpload(video *storage.Video) (*youtube.Video, error) {
+	return buildVideoUpload(video, nil, false)
+}
+
+// buildVideoUpload implements newVideoUpload, taking the default language and category from
+// config when set. With strictLanguage set an invalid language code fails with a language error
+// instead of falling back to the default language.
+func buildVideoUpload(video *storage.Video, config *PublishConfig, strictLanguage bool) (*youtube.Video, error) {
+	if video == nil {
+		return nil, &YouTubeError{Type: ErrorTypeInvalid, Message: "video metadata is required to upload a video"}
+	}
+
+	// Valid timecodes go right below the description so YouTube turns them into chapters
+	timecodes := ""
+	chapters := false
+	if len(video.Timecodes) > 0 && video.Timecodes != "N/A" {
+		if _, err := ParseTimecodes(video.Timecodes); err != nil {
+			LogYouTubeWarn("Uploading without chapters: %v", err)
+			timecodes = fmt.Sprintf("▬▬▬▬▬▬ ⏱ Timecodes ⏱ ▬▬▬▬▬▬\n%s", video.Timecodes)
+		} else {
+			chapters = true
+		}
+	}
+	
+	// Construct Hugo URL from title and category for video description
+	hugoURL := ""
+	if video.Title != "" && video.Gist != "" {
+		category := GetCategoryFromFilePath(video.Gist)
+		hugoURL = ConstructHugoURL(video.Title, category)
+	}
+	additionalInfo := GetAdditionalInfo(hugoURL, video.ProjectName, video.ProjectURL, video.RelatedVideos)
+
+	// The channel template takes part of the limit, the description and its hashtags get the rest
+	limit := MaxDescriptionLength - utf8.RuneCountInString(assembleDescription("", additionalInfo, timecodes))
+	var body string
+	var err error
+	if chapters {
+		body, err = buildDescriptionWithChapters(video, limit)
+	} else {
+		body, err = buildDescription(strings.TrimSpace(video.Description), video.DescriptionTags, limit)
+	}
+	if err != nil {
+		return nil, &YouTubeError{Type: ErrorTypeInvalid, Message: "video description can't be uploaded", OriginalError: err}
+	}
+	description := assembleDescription(body, additionalInfo, timecodes)
 
 	upload := &youtube.Video{
 		Snippet: &youtube.VideoSnippet{
 			Title:       video.Title,
 			Description: description,
-			CategoryId:  "28",
 			ChannelId:   channelID,
 		},
 		Status: &youtube.VideoStatus{
-			PrivacyStatus: "private",
-			PublishAt:     video.Date,
+			PrivacyStatus: PrivacyPrivate,
 		},
 		// MonetizationDetails: &youtube.VideoMonetizationDetails{
 		// 	Access: &youtube.AccessPolicy{
@@ -304,45 +362,34 @@ If you are interested in sponsoring this channel, please visit https://devopstoo
 		// 	},
 		// },
 	}
-	// The API returns a 400 Bad Request response if tags is an empty string.
-	if strings.Trim(video.Tags, "") != "" {
-		upload.Snippet.Tags = strings.Split(video.Tags, ",")
+	if err := ApplyPrivacy(upload, video); err != nil {
+		LogYouTubeWarn("Privacy setting failed, uploading as private: %v", err)
 	}
-
-	// Set language with proper error handling and fallback mechanisms
-	defaultLanguage := configuration.GlobalSettings.VideoDefaults.Language
-	err = ValidateAndSetLanguage(upload, video, defaultLanguage)
-	if err != nil {
-		// Log the error but don't fail the upload
-		LogYouTubeError(CategorizeError(err), "Language setting failed, continuing with upload")
+	ApplyAudience(upload, video, config.madeForKidsOrDefault())
+	if err := ApplyCategory(upload, video, config.categoryOrDefault()); err != nil {
+		LogYouTubeWarn("Category setting failed, continuing with upload: %v", err)
 	}
 
-	call := service.Videos.Insert([]string{"snippet", "status"}, upload)
-	file, err := os.Open(video.UploadVideo)
-	if err != nil {
-		LogYouTubeError(NewUploadError("", err), "Failed to open video file")
-		YouTubeMetrics.IncUploadFailure()
-		log.Fatalf("Error opening %v: %v", video.UploadVideo, err)
+	// Empty tags are never sent since the API rejects them with 400 Bad Request
+	overBudget, tooLong := ApplyTags(upload, video, MaxTagBytes)
+	if len(tooLong) > 0 {
+		LogYouTubeWarn("Dropped %d tag(s) longer than %d characters: %s", len(tooLong), MaxTagLength, strings.Join(tooLong, ", "))
 	}
-	defer file.Close()
-
-	response, err := call.Media(file).Do()
-	if err != nil {
-		LogYouTubeError(CategorizeError(err), "YouTube API upload failed")
-		YouTubeMetrics.IncUploadFailure()
-		log.Fatalf("Error getting response from YouTube during insert: %v", err)
+	if len(overBudget) > 0 {
+		LogYouTubeWarn("Dropped %d tag(s) exceeding the %d byte limit: %s", len(overBudget), MaxTagBytes, strings.Join(overBudget, ", "))
 	}
 
-	// Log successful upload
-	LogUploadOperation(response.Id, true, nil)
-	YouTubeMetrics.IncUploadSuccess()
-	fmt.Printf("Upload successful! Video ID: %v\n", response.Id)
-
-	// Log language information
-	LogYouTubeInfo("Language %s and Audio Language %s applied to video ID %s", 
-		video.AppliedLanguage, video.AppliedAudioLanguage, response.Id)
-
-	return response.Id
+	// Set language with proper error handling and fallback mechanisms
+	defaultLanguage := config.languageOrDefault(configuration.GlobalSettings.VideoDefaults.Language)
+	if strictLanguage {
+		if err := ValidateAndSetLanguageStrict(upload, video, defaultLanguage); err != nil {
+			return nil, err
+		}
+	} else if err := ValidateAndSetLanguage(upload, video, defaultLanguage); err != nil {
+		// Log the error but don't fail the upload
+		LogYouTubeError(CategorizeError(err), "Language setting failed, continuing with upload")
+	}
+	return upload, nil
 }
 
 // GetAdditionalInfoFromPath converts a Hugo path to URL and calls GetAdditionalInfo
@@ -382,6 +429,9 @@ func GetAdditionalInfo(hugoURL, projectName, projectURL, relatedVideosRaw string
 
 
 func UploadThumbnail(video storage.Video) error {
+	if skipForDryRun("set thumbnail %s for video ID %s", video.Thumbnail, video.VideoId) {
+		return nil
+	}
 	client := getClient(context.Background(), &oauth2.Config{Scopes: []string{youtube.YoutubeUploadScope}})
 
 	// FIXME: Remove the comment
@@ -391,18 +441,7 @@ func UploadThumbnail(video storage.Video) error {
 	if err != nil {
 		return err
 	}
-	file, err := os.Open(video.Thumbnail)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-	call := service.Thumbnails.Set(video.VideoId)
-	response, err := call.Media(file).Do()
-	if err != nil {
-		return err
-	}
-	fmt.Printf("Thumbnail uploaded, URL: %s\n", response.Items[0].Default.Url)
-	return nil
+	return SetThumbnail(ctx, service, video.VideoId, video.Thumbnail)
 }
 
 func GetYouTubeURL(videoId string) string {
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultsFileName is the conventional name of the optional file, next to the video files, whose
+// values GetVideoWithDefaults uses for the fields a video leaves empty, such as a sponsorship
+// block shared by many videos.
+const DefaultsFileName = "_defaults.yaml"
+
+// GetVideoWithDefaults reads the video at path like GetVideo, filling every empty field from the
+// video read from defaultsPath. Non-empty fields of the video always win, and nested structs
+// such as Sponsorship are merged field by field. Empty means the zero value, so a video can't
+// turn off a flag the defaults set. A missing defaults file is not an error. The result is meant
+// for reading: writing it back with WriteVideo would save the inherited values into the video
+// file, so code that edits videos should load them with GetVideo.
+func (y *YAML) GetVideoWithDefaults(path, defaultsPath string) (Video, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Video{}, fmt.Errorf("failed to read video file %s: %w", path, err)
+	}
+	var defaults *Video
+	if defaultsPath != "" {
+		dir := filepath.Dir(defaultsPath)
+		defaults, err = readDefaults(os.DirFS(dir), filepath.Base(defaultsPath), dir)
+		if err != nil {
+			return Video{}, err
+		}
+	}
+	return decodeVideo(data, path, defaults)
+}
+
+// decodeVideo unmarshals the video read from path and merges in defaults, when not nil.
+func decodeVideo(data []byte, path string, defaults *Video) (Video, error) {
+	var video Video
+	if err := yaml.Unmarshal(data, &video); err != nil {
+		return video, fmt.Errorf("failed to unmarshal video data from %s: %w", path, err)
+	}
+	if defaults != nil {
+		// The schema version and timestamps describe the video file itself, so they must never be inherited
+		defaults.SchemaVersion = 0
+		defaults.CreatedAt, defaults.UpdatedAt = time.Time{}, time.Time{}
+		mergeDefaults(reflect.ValueOf(&video).Elem(), reflect.ValueOf(defaults).Elem())
+	}
+	MigrateVideo(&video)
+	return video, nil
+}
+
+// readDefaults reads the defaults file name from fsys, returning nil when it doesn't exist.
+// Errors name the file as joined to dir, the directory fsys was opened from.
+func readDefaults(fsys fs.FS, name, dir string) (*Video, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read video defaults file %s: %w", filepath.Join(dir, name), err)
+	}
+	var defaults Video
+	if err := yaml.Unmarshal(data, &defaults); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal video defaults from %s: %w", filepath.Join(dir, name), err)
+	}
+	return &defaults, nil
+}
+
+// mergeDefaults sets every zero field of dst to the matching field of defaults, recursing
+// into nested structs.
+func mergeDefaults(dst, defaults reflect.Value) {
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Field(i)
+		switch {
+		case !field.CanSet():
+		case field.Kind() == reflect.Struct:
+			mergeDefaults(field, defaults.Field(i))
+		case field.IsZero():
+			field.Set(defaults.Field(i))
+		}
+	}
+}
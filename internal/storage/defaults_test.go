@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeYAMLFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestGetVideoWithDefaults(t *testing.T) {
+	dir := t.TempDir()
+	defaultsPath := filepath.Join(dir, DefaultsFileName)
+	writeYAMLFile(t, defaultsPath, `tags: DevOps, Kubernetes
+category: development
+code: true
+sponsorship:
+  amount: "1000"
+  emails: sponsor@example.com
+schemaVersion: 99
+`)
+	videoPath := filepath.Join(dir, "video.yaml")
+	writeYAMLFile(t, videoPath, `name: Video
+category: ai
+sponsorship:
+  amount: "2000"
+`)
+
+	video, err := (&YAML{}).GetVideoWithDefaults(videoPath, defaultsPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Video", video.Name)
+	assert.Equal(t, "ai", video.Category, "per-video values override defaults")
+	assert.Equal(t, "DevOps, Kubernetes", video.Tags, "empty fields inherit defaults")
+	assert.True(t, video.Code)
+	assert.Equal(t, "2000", video.Sponsorship.Amount)
+	assert.Equal(t, "sponsor@example.com", video.Sponsorship.Emails, "nested fields inherit defaults one by one")
+	assert.Equal(t, CurrentSchemaVersion, video.SchemaVersion, "the schema version is never inherited")
+}
+
+func TestGetVideoWithDefaults_MissingDefaults(t *testing.T) {
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "video.yaml")
+	writeYAMLFile(t, videoPath, "name: Video\n")
+
+	video, err := (&YAML{}).GetVideoWithDefaults(videoPath, filepath.Join(dir, DefaultsFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "Video", video.Name)
+	assert.Empty(t, video.Tags)
+}
+
+func TestGetVideoWithDefaults_InvalidDefaults(t *testing.T) {
+	dir := t.TempDir()
+	defaultsPath := filepath.Join(dir, DefaultsFileName)
+	writeYAMLFile(t, defaultsPath, "tags: [unclosed\n")
+	videoPath := filepath.Join(dir, "video.yaml")
+	writeYAMLFile(t, videoPath, "name: Video\n")
+
+	_, err := (&YAML{}).GetVideoWithDefaults(videoPath, defaultsPath)
+	assert.ErrorContains(t, err, "failed to unmarshal video defaults")
+}
+
+func TestGetVideo_IgnoresDefaults(t *testing.T) {
+	dir := t.TempDir()
+	writeYAMLFile(t, filepath.Join(dir, DefaultsFileName), "tags: DevOps\n")
+	videoPath := filepath.Join(dir, "video.yaml")
+	writeYAMLFile(t, videoPath, "name: Video\n")
+
+	y := &YAML{}
+	video, err := y.GetVideo(videoPath)
+	require.NoError(t, err)
+	assert.Empty(t, video.Tags)
+
+	// Saving an edited video must not bake the defaults into its file
+	video.Title = "Edited"
+	require.NoError(t, y.WriteVideo(video, videoPath))
+	withDefaults, err := y.GetVideoWithDefaults(videoPath, filepath.Join(dir, DefaultsFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "Edited", withDefaults.Title)
+	assert.Equal(t, "DevOps", withDefaults.Tags, "the defaults still apply after the write")
+
+	saved, err := os.ReadFile(videoPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(saved), "DevOps")
+}
@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+)
+
+// FieldChange is a field whose value differs between two versions of a video.
+type FieldChange struct {
+	Field    string // The constants.FieldTitle* label of the field, or its Go name when it has none
+	OldValue string
+	NewValue string
+}
+
+// fieldTitles maps Video field names, nested ones as "Sponsorship.Amount", to the titles the
+// CLI form shows for them.
+var fieldTitles = map[string]string{
+	"ProjectName":         constants.FieldTitleProjectName,
+	"ProjectURL":          constants.FieldTitleProjectURL,
+	"Sponsorship.Amount":  constants.FieldTitleSponsorshipAmount,
+	"Sponsorship.Emails":  constants.FieldTitleSponsorshipEmails,
+	"Sponsorship.Blocked": constants.FieldTitleSponsorshipBlocked,
+	"Date":                constants.FieldTitlePublishDate,
+	"Delayed":             constants.FieldTitleDelayed,
+	"Gist":                constants.FieldTitleGistPath,
+	"Code":                constants.FieldTitleCodeDone,
+	"Head":                constants.FieldTitleTalkingHeadDone,
+	"Screen":              constants.FieldTitleScreenRecordingDone,
+	"RelatedVideos":       constants.FieldTitleRelatedVideos,
+	"Thumbnails":          constants.FieldTitleThumbnailsDone,
+	"Diagrams":            constants.FieldTitleDiagramsDone,
+	"Screenshots":         constants.FieldTitleScreenshotsDone,
+	"Location":            constants.FieldTitleFilesLocation,
+	"Tagline":             constants.FieldTitleTagline,
+	"TaglineIdeas":        constants.FieldTitleTaglineIdeas,
+	"OtherLogos":          constants.FieldTitleOtherLogos,
+	"Title":               constants.FieldTitleTitle,
+	"Description":         constants.FieldTitleDescription,
+	"Tags":                constants.FieldTitleTags,
+	"DescriptionTags":     constants.FieldTitleDescriptionTags,
+	"Tweet":               constants.FieldTitleTweet,
+	"Animations":          constants.FieldTitleAnimationsScript,
+	"Thumbnail":           constants.FieldTitleThumbnailPath,
+	"Members":             constants.FieldTitleMembers,
+	"RequestEdit":         constants.FieldTitleRequestEdit,
+	"Timecodes":           constants.FieldTitleTimecodes,
+	"Movie":               constants.FieldTitleMovieDone,
+	"Slides":              constants.FieldTitleSlidesDone,
+	"UploadVideo":         constants.FieldTitleVideoFilePath,
+	"VideoId":             constants.FieldTitleCurrentVideoID,
+	"HugoPath":            constants.FieldTitleCreateHugo,
+	"DOTPosted":           constants.FieldTitleDOTPosted,
+	"BlueSkyPosted":       constants.FieldTitleBlueSkyPosted,
+	"LinkedInPosted":      constants.FieldTitleLinkedInPosted,
+	"SlackPosted":         constants.FieldTitleSlackPosted,
+	"YouTubeHighlight":    constants.FieldTitleYouTubeHighlight,
+	"YouTubeComment":      constants.FieldTitleYouTubeComment,
+	"YouTubeCommentReply": constants.FieldTitleYouTubeCommentReply,
+	"GDE":                 constants.FieldTitleGDEPosted,
+	"Repo":                constants.FieldTitleCodeRepository,
+	"NotifiedSponsors":    constants.FieldTitleNotifySponsors,
+}
+
+// DiffVideos returns the fields that differ between oldVideo and newVideo, in the order they
+// are declared in Video, with nested Sponsorship fields compared individually. Values are
+// formatted as text: booleans as "true"/"false" and times as RFC 3339, empty when unset, and
+// optional values by what they point to, empty when nil. Sponsor emails are redacted with
+// RedactEmail, since the changes end up in logs and audit trails.
+func DiffVideos(oldVideo, newVideo Video) []FieldChange {
+	return diffFields(reflect.ValueOf(oldVideo), reflect.ValueOf(newVideo), "")
+}
+
+func diffFields(oldValue, newValue reflect.Value, prefix string) []FieldChange {
+	var changes []FieldChange
+	for i := 0; i < oldValue.NumField(); i++ {
+		field := oldValue.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := prefix + field.Name
+		oldField, newField := oldValue.Field(i), newValue.Field(i)
+		if field.Type.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Time{}) {
+			changes = append(changes, diffFields(oldField, newField, name+".")...)
+			continue
+		}
+		oldText, newText := formatFieldValue(oldField), formatFieldValue(newField)
+		if oldText == newText {
+			continue
+		}
+		if name == "Sponsorship.Emails" {
+			oldText, newText = redactEmailList(oldText), redactEmailList(newText)
+		}
+		label := name
+		if title, ok := fieldTitles[name]; ok {
+			label = title
+		}
+		changes = append(changes, FieldChange{Field: label, OldValue: oldText, NewValue: newText})
+	}
+	return changes
+}
+
+// redactEmailList returns the comma-separated emails with every address masked by RedactEmail.
+func redactEmailList(emails string) string {
+	return strings.Join(Sponsorship{Emails: emails}.RedactedEmails(), ", ")
+}
+
+// formatFieldValue returns the text DiffVideos reports for a field value.
+func formatFieldValue(value reflect.Value) string {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+	if t, ok := value.Interface().(time.Time); ok {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffVideos_SingleField(t *testing.T) {
+	oldVideo := Video{Name: "video", Title: "Old Title", Movie: true}
+	newVideo := oldVideo
+	newVideo.Title = "New Title"
+
+	assert.Equal(t, []FieldChange{
+		{Field: constants.FieldTitleTitle, OldValue: "Old Title", NewValue: "New Title"},
+	}, DiffVideos(oldVideo, newVideo))
+}
+
+func TestDiffVideos_Sponsorship(t *testing.T) {
+	oldVideo := Video{Sponsorship: Sponsorship{Amount: "-"}}
+	newVideo := Video{Sponsorship: Sponsorship{Amount: "1000", Emails: "sponsor@example.com"}}
+
+	assert.Equal(t, []FieldChange{
+		{Field: constants.FieldTitleSponsorshipAmount, OldValue: "-", NewValue: "1000"},
+		{Field: constants.FieldTitleSponsorshipEmails, OldValue: "", NewValue: "s***@example.com"},
+	}, DiffVideos(oldVideo, newVideo), "sponsor emails are redacted")
+}
+
+func TestDiffVideos_RedactsEmailsThatStillDiffer(t *testing.T) {
+	oldVideo := Video{Sponsorship: Sponsorship{Emails: "alice@example.com"}}
+	newVideo := Video{Sponsorship: Sponsorship{Emails: "anna@example.com, bob@example.org"}}
+	changed := Video{Sponsorship: Sponsorship{Emails: "anna@example.com"}}
+
+	assert.Equal(t, []FieldChange{
+		{Field: constants.FieldTitleSponsorshipEmails, OldValue: "a***@example.com", NewValue: "a***@example.com, b***@example.org"},
+	}, DiffVideos(oldVideo, newVideo))
+	assert.Len(t, DiffVideos(oldVideo, changed), 1, "changes are detected before redacting")
+}
+
+func TestDiffVideos_NoChange(t *testing.T) {
+	video := completeVideo()
+	video.UpdatedAt = time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	assert.Empty(t, DiffVideos(video, video))
+	assert.Empty(t, DiffVideos(Video{}, Video{}))
+}
+
+func TestDiffVideos_FieldsWithoutTitle(t *testing.T) {
+	newVideo := Video{
+		Language:      "es",
+		Unlisted:      true,
+		MadeForKids:   new(bool),
+		SchemaVersion: 2,
+		UpdatedAt:     time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, []FieldChange{
+		{Field: "Language", OldValue: "", NewValue: "es"},
+		{Field: "Unlisted", OldValue: "false", NewValue: "true"},
+		{Field: "MadeForKids", OldValue: "", NewValue: "false"},
+		{Field: "UpdatedAt", OldValue: "", NewValue: "2025-01-01T10:00:00Z"},
+		{Field: "SchemaVersion", OldValue: "0", NewValue: "2"},
+	}, DiffVideos(Video{}, newVideo))
+}
+
+func TestDiffVideos_CoversEveryField(t *testing.T) {
+	var newVideo Video
+	populate(reflect.ValueOf(&newVideo).Elem(), "video")
+
+	labels := make(map[string]bool)
+	for _, change := range DiffVideos(Video{}, newVideo) {
+		require.False(t, labels[change.Field], "duplicate label %q", change.Field)
+		labels[change.Field] = true
+	}
+	for name, title := range fieldTitles {
+		assert.True(t, labels[title], "%s must be reported as %q", name, title)
+	}
+}
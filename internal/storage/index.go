@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// indexKey identifies a video by its name and category.
+type indexKey struct {
+	name     string
+	category string
+}
+
+// FindDuplicates returns groups of index entries sharing the same Name and Category.
+// Only groups with more than one member are returned, in order of first occurrence.
+// It returns nil when there are no duplicates or the index can't be read.
+func (y *YAML) FindDuplicates() [][]VideoIndex {
+	index, err := y.GetIndex()
+	if err != nil {
+		return nil
+	}
+
+	groups := make(map[indexKey][]VideoIndex)
+	var order []indexKey
+	for _, vi := range index {
+		key := indexKey{name: vi.Name, category: vi.Category}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], vi)
+	}
+
+	var duplicates [][]VideoIndex
+	for _, key := range order {
+		if len(groups[key]) > 1 {
+			duplicates = append(duplicates, groups[key])
+		}
+	}
+	return duplicates
+}
+
+// DedupeIndex removes duplicate Name+Category entries from the index, keeping the first
+// occurrence, and rewrites the index file if anything was removed. It returns the number
+// of entries removed.
+func (y *YAML) DedupeIndex() (int, error) {
+	index, err := y.GetIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	seen := make(map[indexKey]bool)
+	deduped := make([]VideoIndex, 0, len(index))
+	for _, vi := range index {
+		key := indexKey{name: vi.Name, category: vi.Category}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		deduped = append(deduped, vi)
+	}
+
+	removed := len(index) - len(deduped)
+	if removed == 0 {
+		return 0, nil
+	}
+	if err := y.WriteIndex(deduped); err != nil {
+		return 0, err
+	}
+	return removed, nil
+}
+
+// QueryOptions filters the entries returned by QueryIndex. Zero values match everything.
+type QueryOptions struct {
+	NameContains string // Case-insensitive substring the name must contain
+	Category     string // Exact category the entry must belong to
+	Limit        int    // Maximum number of entries to return; zero or negative means no limit
+}
+
+// QueryIndex returns the index entries matching opts, sorted by Name. Entries with equal
+// names keep their index order.
+func (y *YAML) QueryIndex(opts QueryOptions) ([]VideoIndex, error) {
+	index, err := y.GetIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	nameContains := strings.ToLower(opts.NameContains)
+	matches := make([]VideoIndex, 0, len(index))
+	for _, vi := range index {
+		if opts.Category != "" && vi.Category != opts.Category {
+			continue
+		}
+		if nameContains != "" && !strings.Contains(strings.ToLower(vi.Name), nameContains) {
+			continue
+		}
+		matches = append(matches, vi)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Name < matches[j].Name
+	})
+	if opts.Limit > 0 && len(matches) > opts.Limit {
+		matches = matches[:opts.Limit]
+	}
+	return matches, nil
+}
+
+// GetIndexSortedByPublishDate returns the index entries ordered by the publish date of their
+// videos, earliest first when ascending and latest first otherwise. Entries whose video has no
+// date, an unparsable one or can't be read sort last in both directions; entries with equal
+// dates keep their index order.
+func (y *YAML) GetIndexSortedByPublishDate(ascending bool) ([]VideoIndex, error) {
+	index, err := y.GetIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	type datedEntry struct {
+		entry VideoIndex
+		date  time.Time // Zero when the video is undated
+	}
+	entries := make([]datedEntry, len(index))
+	for i, vi := range index {
+		entries[i].entry = vi
+		if video, err := y.GetVideo(y.VideoPath(vi)); err == nil {
+			entries[i].date, _ = video.ParsedPublishDate()
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].date, entries[j].date
+		switch {
+		case a.IsZero() || b.IsZero():
+			return !a.IsZero() && b.IsZero()
+		case ascending:
+			return a.Before(b)
+		default:
+			return a.After(b)
+		}
+	})
+
+	sorted := make([]VideoIndex, len(entries))
+	for i, e := range entries {
+		sorted[i] = e.entry
+	}
+	return sorted, nil
+}
@@ -0,0 +1,194 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestIndex writes index to a temporary index file and returns a YAML instance for it.
+func writeTestIndex(t *testing.T, index []VideoIndex) *YAML {
+	t.Helper()
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+	require.NoError(t, y.WriteIndex(index))
+	return y
+}
+
+func TestFindDuplicates_CleanIndex(t *testing.T) {
+	y := writeTestIndex(t, []VideoIndex{
+		{Name: "first", Category: "testing"},
+		{Name: "first", Category: "other"},
+		{Name: "second", Category: "testing"},
+	})
+
+	assert.Empty(t, y.FindDuplicates())
+
+	removed, err := y.DedupeIndex()
+	require.NoError(t, err)
+	assert.Equal(t, 0, removed)
+}
+
+func TestFindDuplicates_WithDuplicates(t *testing.T) {
+	y := writeTestIndex(t, []VideoIndex{
+		{Name: "first", Category: "testing"},
+		{Name: "second", Category: "testing"},
+		{Name: "first", Category: "testing"},
+		{Name: "second", Category: "testing"},
+		{Name: "first", Category: "testing"},
+		{Name: "third", Category: "testing"},
+	})
+
+	assert.Equal(t, [][]VideoIndex{
+		{{Name: "first", Category: "testing"}, {Name: "first", Category: "testing"}, {Name: "first", Category: "testing"}},
+		{{Name: "second", Category: "testing"}, {Name: "second", Category: "testing"}},
+	}, y.FindDuplicates())
+
+	removed, err := y.DedupeIndex()
+	require.NoError(t, err)
+	assert.Equal(t, 3, removed)
+
+	index, err := y.GetIndex()
+	require.NoError(t, err)
+	assert.Equal(t, []VideoIndex{
+		{Name: "first", Category: "testing"},
+		{Name: "second", Category: "testing"},
+		{Name: "third", Category: "testing"},
+	}, index)
+	assert.Empty(t, y.FindDuplicates())
+}
+
+func TestDedupeIndex_MissingIndex(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "missing.yaml"))
+
+	assert.Nil(t, y.FindDuplicates())
+	_, err := y.DedupeIndex()
+	assert.Error(t, err)
+}
+
+func TestQueryIndex(t *testing.T) {
+	y := writeTestIndex(t, []VideoIndex{
+		{Name: "Kubernetes Operators", Category: "kubernetes"},
+		{Name: "argo cd intro", Category: "gitops"},
+		{Name: "Crossplane Compositions", Category: "kubernetes"},
+		{Name: "Flux vs Argo CD", Category: "gitops"},
+		{Name: "Argo Rollouts", Category: "kubernetes"},
+	})
+
+	tests := []struct {
+		name     string
+		opts     QueryOptions
+		expected []string
+	}{
+		{
+			name:     "No filters sorts by name",
+			opts:     QueryOptions{},
+			expected: []string{"Argo Rollouts", "Crossplane Compositions", "Flux vs Argo CD", "Kubernetes Operators", "argo cd intro"},
+		},
+		{
+			name:     "Category filter",
+			opts:     QueryOptions{Category: "gitops"},
+			expected: []string{"Flux vs Argo CD", "argo cd intro"},
+		},
+		{
+			name:     "Mixed case substring",
+			opts:     QueryOptions{NameContains: "ARGO"},
+			expected: []string{"Argo Rollouts", "Flux vs Argo CD", "argo cd intro"},
+		},
+		{
+			name:     "Substring and category",
+			opts:     QueryOptions{NameContains: "argo", Category: "kubernetes"},
+			expected: []string{"Argo Rollouts"},
+		},
+		{
+			name:     "Limit",
+			opts:     QueryOptions{NameContains: "argo", Limit: 2},
+			expected: []string{"Argo Rollouts", "Flux vs Argo CD"},
+		},
+		{
+			name:     "Category is exact",
+			opts:     QueryOptions{Category: "Kubernetes"},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := y.QueryIndex(tt.opts)
+			require.NoError(t, err)
+			names := make([]string, 0, len(results))
+			for _, vi := range results {
+				names = append(names, vi.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestWriteIndex_TracksPhase(t *testing.T) {
+	index := []VideoIndex{
+		{Name: "started", Category: "testing"},
+		{Name: "done", Category: "testing"},
+		{Name: "missing", Category: "testing", Phase: constants.PhaseTitleDefinition},
+		{Name: "given", Category: "testing", Phase: constants.PhaseTitleDefinition},
+	}
+	y := setupVideoFixtures(t, index, map[string]Video{
+		"started": {Name: "started", Category: "testing", ProjectName: "Crossplane"},
+		"done":    completeVideo(),
+		"given":   completeVideo(),
+	})
+
+	require.NoError(t, y.WriteIndex(index))
+	assert.Empty(t, index[0].Phase, "the caller's index must not be modified")
+
+	written, err := y.GetIndex()
+	require.NoError(t, err)
+	assert.Equal(t, []VideoIndex{
+		{Name: "started", Category: "testing", Phase: constants.PhaseTitleInitialDetails},
+		{Name: "done", Category: "testing", Phase: IndexPhaseComplete},
+		{Name: "missing", Category: "testing", Phase: constants.PhaseTitleDefinition},
+		{Name: "given", Category: "testing", Phase: constants.PhaseTitleDefinition},
+	}, written, "only entries without a phase are read from their video")
+}
+
+func TestWriteVideo_UpdatesIndexPhase(t *testing.T) {
+	index := []VideoIndex{
+		{Name: "first", Category: "testing"},
+		{Name: "second", Category: "testing"},
+	}
+	y := setupVideoFixtures(t, index, map[string]Video{
+		"first":  {Name: "first", Category: "testing"},
+		"second": {Name: "second", Category: "testing"},
+	})
+
+	require.NoError(t, y.WriteVideo(completeVideo(), y.VideoPath(index[0])))
+
+	written, err := y.GetIndex()
+	require.NoError(t, err)
+	assert.Equal(t, []VideoIndex{
+		{Name: "first", Category: "testing", Phase: IndexPhaseComplete},
+		{Name: "second", Category: "testing", Phase: constants.PhaseTitleInitialDetails},
+	}, written)
+}
+
+func TestWriteVideo_WithoutIndex(t *testing.T) {
+	dir := t.TempDir()
+	y := NewYAML(filepath.Join(dir, "index.yaml"))
+	path := filepath.Join(dir, "video.yaml")
+
+	require.NoError(t, y.WriteVideo(completeVideo(), path), "a missing index is not an error")
+	_, err := os.Stat(y.IndexPath)
+	assert.ErrorIs(t, err, os.ErrNotExist, "the index must not be created")
+}
+
+func TestGetIndex_LegacyIndexWithoutPhase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("- name: Old Video\n  category: testing\n"), 0644))
+
+	index, err := NewYAML(path).GetIndex()
+	require.NoError(t, err)
+	assert.Equal(t, []VideoIndex{{Name: "Old Video", Category: "testing"}}, index)
+}
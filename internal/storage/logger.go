@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+)
+
+var storageLog *logrus.Logger
+
+func init() {
+	storageLog = logrus.New()
+	storageLog.SetFormatter(&logrus.JSONFormatter{})
+	storageLog.SetLevel(logrus.InfoLevel)
+	storageLog.SetOutput(os.Stdout)
+}
+
+// SetLogLevel changes the log level for storage operations.
+func SetLogLevel(level logrus.Level) {
+	storageLog.SetLevel(level)
+}
+
+func baseEntry() *logrus.Entry {
+	return storageLog.WithField("component", "storage")
+}
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"bytes"
+	"testing"
+)
+
+// captureLogs redirects the storage logs to a buffer for the duration of a test.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	originalOut := storageLog.Out
+	t.Cleanup(func() {
+		storageLog.SetOutput(originalOut)
+	})
+
+	var buf bytes.Buffer
+	storageLog.SetOutput(&buf)
+	return &buf
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// MemberList splits the comma-separated Members field into individual names, trimming
+// whitespace and dropping empty entries and duplicates (case-insensitively). The first
+// spelling of a duplicated name is kept.
+func (v Video) MemberList() []string {
+	var members []string
+	seen := make(map[string]bool)
+	for _, member := range strings.Split(v.Members, ",") {
+		member = strings.TrimSpace(member)
+		if member == "" || seen[strings.ToLower(member)] {
+			continue
+		}
+		seen[strings.ToLower(member)] = true
+		members = append(members, member)
+	}
+	return members
+}
+
+// ValidateMembers checks that every name in MemberList looks like a name or handle: letters,
+// digits, spaces and the . - ' _ @ characters. It returns an error listing each suspicious one.
+func (v Video) ValidateMembers() error {
+	var errs []error
+	for _, member := range v.MemberList() {
+		if i := strings.IndexFunc(member, suspiciousMemberRune); i >= 0 {
+			errs = append(errs, fmt.Errorf("suspicious member name %q: unexpected character %q", member, []rune(member[i:])[0]))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func suspiciousMemberRune(r rune) bool {
+	if unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == ' ' {
+		return false
+	}
+	return !strings.ContainsRune(".-'_@", r)
+}
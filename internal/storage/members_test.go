@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVideo_MemberList(t *testing.T) {
+	tests := []struct {
+		name     string
+		members  string
+		expected []string
+	}{
+		{name: "empty", members: "", expected: nil},
+		{name: "single", members: "Viktor Farcic", expected: []string{"Viktor Farcic"}},
+		{name: "trailing comma", members: "Viktor Farcic, Darin Pope,", expected: []string{"Viktor Farcic", "Darin Pope"}},
+		{name: "stray commas", members: ",Viktor Farcic,,Darin Pope", expected: []string{"Viktor Farcic", "Darin Pope"}},
+		{name: "whitespace", members: "  Viktor Farcic ,\tDarin Pope  ", expected: []string{"Viktor Farcic", "Darin Pope"}},
+		{name: "duplicates", members: "Viktor Farcic, Darin Pope, viktor farcic, Darin Pope", expected: []string{"Viktor Farcic", "Darin Pope"}},
+		{name: "only separators", members: " , ,", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Video{Members: tt.members}.MemberList())
+		})
+	}
+}
+
+func TestVideo_ValidateMembers(t *testing.T) {
+	assert.NoError(t, Video{}.ValidateMembers())
+	assert.NoError(t, Video{Members: "Viktor Farcic, Zoë O'Brien, jean-luc.picard, @vfarcic, user_42,"}.ValidateMembers())
+
+	err := Video{Members: "Viktor Farcic, <script>, Darin; Pope"}.ValidateMembers()
+	assert.ErrorContains(t, err, `suspicious member name "<script>"`)
+	assert.ErrorContains(t, err, `suspicious member name "Darin; Pope": unexpected character ';'`)
+	assert.NotContains(t, err.Error(), `"Viktor Farcic"`)
+}
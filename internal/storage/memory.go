@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+)
+
+// MemoryStore is a Store that keeps videos and the index in memory, keyed by path, so code
+// depending on storage can be tested without touching the filesystem. It is safe for
+// concurrent use.
+type MemoryStore struct {
+	mu       sync.Mutex
+	videos   map[string]Video
+	index    []VideoIndex
+	hasIndex bool
+
+	// FailNextWrite, when set, is returned by the next WriteVideo or WriteIndex call instead
+	// of writing, and then cleared.
+	FailNextWrite error
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{videos: make(map[string]Video)}
+}
+
+// MemoryStore must keep satisfying Store
+var _ Store = (*MemoryStore)(nil)
+
+// GetVideo returns the video written to path, failing with an error wrapping os.ErrNotExist
+// when there is none, like YAML does.
+func (s *MemoryStore) GetVideo(path string) (Video, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	video, ok := s.videos[path]
+	if !ok {
+		return Video{}, fmt.Errorf("failed to read video file %s: %w", path, os.ErrNotExist)
+	}
+	return video, nil
+}
+
+// WriteVideo stores the video under path, setting its schema version and timestamps like YAML
+// does.
+func (s *MemoryStore) WriteVideo(video Video, path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.injectedError(); err != nil {
+		return fmt.Errorf("failed to write video data to file %s: %w", path, err)
+	}
+	if video.SchemaVersion == 0 {
+		video.SchemaVersion = CurrentSchemaVersion
+	}
+	var saved *Video
+	if previous, ok := s.videos[path]; ok {
+		saved = &previous
+		if video.CreatedAt.IsZero() {
+			video.CreatedAt = previous.CreatedAt
+		}
+	}
+	stampTimes(&video, saved)
+	s.videos[path] = video
+	return nil
+}
+
+// GetIndex returns a copy of the index, failing with an error wrapping os.ErrNotExist when
+// none was written yet, like YAML does.
+func (s *MemoryStore) GetIndex() ([]VideoIndex, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.hasIndex {
+		return nil, fmt.Errorf("failed to read index file: %w", os.ErrNotExist)
+	}
+	return slices.Clone(s.index), nil
+}
+
+// WriteIndex replaces the index with a copy of index.
+func (s *MemoryStore) WriteIndex(index []VideoIndex) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.injectedError(); err != nil {
+		return fmt.Errorf("failed to write video index: %w", err)
+	}
+	s.index, s.hasIndex = slices.Clone(index), true
+	return nil
+}
+
+// injectedError returns and clears FailNextWrite. The caller must hold s.mu.
+func (s *MemoryStore) injectedError() error {
+	err := s.FailNextWrite
+	s.FailNextWrite = nil
+	return err
+}
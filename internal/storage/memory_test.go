@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	testStoreRoundTrip(t, NewMemoryStore(), "manuscript/testing/video.yaml")
+}
+
+func TestMemoryStore_MissingData(t *testing.T) {
+	testStoreMissingData(t, NewMemoryStore(), "missing.yaml")
+}
+
+func TestMemoryStore_FailNextWrite(t *testing.T) {
+	store := NewMemoryStore()
+	diskFull := errors.New("disk full")
+
+	store.FailNextWrite = diskFull
+	err := store.WriteVideo(Video{Name: "Video"}, "video.yaml")
+	assert.ErrorIs(t, err, diskFull)
+	_, err = store.GetVideo("video.yaml")
+	assert.Error(t, err, "a failed write must not store the video")
+
+	require.NoError(t, store.WriteVideo(Video{Name: "Video"}, "video.yaml"), "only the next write fails")
+
+	store.FailNextWrite = diskFull
+	assert.ErrorIs(t, store.WriteIndex([]VideoIndex{{Name: "Video"}}), diskFull)
+	require.NoError(t, store.WriteIndex([]VideoIndex{{Name: "Video"}}))
+}
+
+func TestMemoryStore_ReturnsCopies(t *testing.T) {
+	store := NewMemoryStore()
+	index := []VideoIndex{{Name: "Video", Category: "testing"}}
+	require.NoError(t, store.WriteIndex(index))
+
+	index[0].Name = "Changed"
+	read, err := store.GetIndex()
+	require.NoError(t, err)
+	assert.Equal(t, "Video", read[0].Name)
+}
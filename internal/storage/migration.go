@@ -0,0 +1,19 @@
+package storage
+
+// CurrentSchemaVersion is the schema version written by WriteVideo.
+// Files without a schemaVersion field are treated as version 0.
+const CurrentSchemaVersion = 1
+
+// MigrateVideo upgrades a video loaded from an older schema to CurrentSchemaVersion,
+// returning true if anything was changed. Records already at (or beyond) the current
+// version are left untouched. Version 1 only added the version itself, so older records
+// just get stamped; their fields, such as an empty AudioLanguage meaning the configured
+// default, keep their meaning.
+func MigrateVideo(v *Video) bool {
+	if v == nil || v.SchemaVersion >= CurrentSchemaVersion {
+		return false
+	}
+
+	v.SchemaVersion = CurrentSchemaVersion
+	return true
+}
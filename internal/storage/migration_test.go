@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateVideo(t *testing.T) {
+	tests := []struct {
+		name          string
+		video         Video
+		expectChanged bool
+		expected      Video
+	}{
+		{
+			name:          "v0 is stamped with the current version",
+			video:         Video{Language: "es"},
+			expectChanged: true,
+			expected:      Video{Language: "es", SchemaVersion: CurrentSchemaVersion},
+		},
+		{
+			name:          "v0 keeps existing audio language",
+			video:         Video{Language: "es", AudioLanguage: "en"},
+			expectChanged: true,
+			expected:      Video{Language: "es", AudioLanguage: "en", SchemaVersion: CurrentSchemaVersion},
+		},
+		{
+			name:          "current version is untouched",
+			video:         Video{Language: "es", SchemaVersion: CurrentSchemaVersion},
+			expectChanged: false,
+			expected:      Video{Language: "es", SchemaVersion: CurrentSchemaVersion},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			video := tt.video
+			assert.Equal(t, tt.expectChanged, MigrateVideo(&video))
+			assert.Equal(t, tt.expected, video)
+		})
+	}
+}
+
+func TestMigrateVideo_Nil(t *testing.T) {
+	assert.False(t, MigrateVideo(nil))
+}
+
+func TestGetVideo_MigratesV0File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("name: Legacy Video\nlanguage: fr\n"), 0644))
+
+	y := YAML{}
+	video, err := y.GetVideo(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, CurrentSchemaVersion, video.SchemaVersion)
+	assert.Equal(t, "fr", video.Language)
+	assert.Empty(t, video.AudioLanguage, "an unset audio language keeps meaning the configured default")
+	assert.Equal(t, "Legacy Video", video.Name)
+}
+
+func TestWriteVideo_SetsSchemaVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "video.yaml")
+
+	y := YAML{}
+	require.NoError(t, y.WriteVideo(Video{Name: "New Video"}, path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "schemaVersion: 1")
+}
@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// videoLocks holds one mutex per video path so RunOnce calls for the same file are serialized.
+var videoLocks sync.Map
+
+// RunOnce runs action unless the flag selected by field is already set, either on video or on
+// the video the store has at path. When action succeeds the flag is set and the video is written
+// to path. Calls for the same path are serialized, so the check, the action and the write happen
+// as one step and concurrent runs can't both perform the action. It reports whether action ran.
+func RunOnce(store Store, path string, video *Video, field func(*Video) *bool, action func() error) (ran bool, err error) {
+	lock, _ := videoLocks.LoadOrStore(path, &sync.Mutex{})
+	mu := lock.(*sync.Mutex)
+	mu.Lock()
+	defer mu.Unlock()
+
+	if *field(video) {
+		return false, nil
+	}
+	stored, err := store.GetVideo(path)
+	switch {
+	case err == nil && *field(&stored):
+		*field(video) = true
+		return false, nil
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return false, fmt.Errorf("failed to check video %s: %w", path, err)
+	}
+
+	if err := action(); err != nil {
+		return true, err
+	}
+	*field(video) = true
+	if err := store.WriteVideo(*video, path); err != nil {
+		return true, fmt.Errorf("action succeeded but the video could not be saved: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func slackPostedField(v *Video) *bool { return &v.SlackPosted }
+
+func TestRunOnce_SecondCallIsNoOp(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	video := &Video{Name: "video"}
+
+	calls := 0
+	action := func() error {
+		calls++
+		return nil
+	}
+
+	ran, err := RunOnce(y, path, video, slackPostedField, action)
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.True(t, video.SlackPosted)
+
+	stored, err := y.GetVideo(path)
+	require.NoError(t, err)
+	assert.True(t, stored.SlackPosted, "the flag should be persisted")
+
+	ran, err = RunOnce(y, path, video, slackPostedField, action)
+	require.NoError(t, err)
+	assert.False(t, ran)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRunOnce_UsesFlagFromDisk(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	require.NoError(t, y.WriteVideo(Video{Name: "video", SlackPosted: true}, path))
+
+	stale := &Video{Name: "video"}
+	ran, err := RunOnce(y, path, stale, slackPostedField, func() error {
+		t.Fatal("action must not run when the stored video is already flagged")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.False(t, ran)
+	assert.True(t, stale.SlackPosted)
+}
+
+func TestRunOnce_FailedActionLeavesFlagUnset(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	video := &Video{Name: "video"}
+
+	ran, err := RunOnce(y, path, video, slackPostedField, func() error {
+		return errors.New("webhook down")
+	})
+	assert.EqualError(t, err, "webhook down")
+	assert.True(t, ran)
+	assert.False(t, video.SlackPosted)
+	assert.NoFileExists(t, path)
+}
+
+func TestRunOnce_ConcurrentCallsRunOnce(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+	path := filepath.Join(t.TempDir(), "video.yaml")
+
+	var calls int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := RunOnce(y, path, &Video{Name: "video"}, slackPostedField, func() error {
+				atomic.AddInt32(&calls, 1)
+				return nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRunOnce_MemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+	video := &Video{Name: "video"}
+
+	calls := 0
+	for i := 0; i < 2; i++ {
+		_, err := RunOnce(store, "video.yaml", video, slackPostedField, func() error {
+			calls++
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, calls)
+
+	stored, err := store.GetVideo("video.yaml")
+	require.NoError(t, err)
+	assert.True(t, stored.SlackPosted)
+}
+
+func TestRunOnce_SaveFailure(t *testing.T) {
+	store := NewMemoryStore()
+	store.FailNextWrite = errors.New("disk full")
+	video := &Video{Name: "video"}
+
+	ran, err := RunOnce(store, "video.yaml", video, slackPostedField, func() error { return nil })
+	assert.True(t, ran)
+	assert.ErrorContains(t, err, "action succeeded but the video could not be saved")
+	assert.True(t, video.SlackPosted, "the action ran, so the flag stays set on the video")
+}
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"strings"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+)
+
+// phaseOrder lists the workflow phases in the order a video moves through them.
+var phaseOrder = []string{
+	constants.PhaseTitleInitialDetails,
+	constants.PhaseTitleWorkProgress,
+	constants.PhaseTitleDefinition,
+	constants.PhaseTitlePostProduction,
+	constants.PhaseTitlePublishingDetails,
+	constants.PhaseTitlePostPublish,
+}
+
+// Progress returns the number of completed and total tasks across all phases.
+func (v Video) Progress() (completed, total int) {
+	for _, phase := range phaseOrder {
+		c, t := v.PhaseProgress(phase)
+		completed += c
+		total += t
+	}
+	return completed, total
+}
+
+// CurrentPhase returns the first phase, in workflow order, that still has incomplete tasks.
+// It returns an empty string once every phase is complete.
+func (v Video) CurrentPhase() string {
+	for _, phase := range phaseOrder {
+		if completed, total := v.PhaseProgress(phase); completed < total {
+			return phase
+		}
+	}
+	return ""
+}
+
+// IsComplete reports whether every task in every phase is done.
+func (v Video) IsComplete() bool {
+	return v.CurrentPhase() == ""
+}
+
+// PhaseProgress returns the number of completed and total tasks for one of the
+// constants.PhaseTitle* phases. Unknown phases report 0, 0.
+//
+// Tasks counted per phase:
+//   - Initial Details: ProjectName, ProjectURL, Gist, Date, Sponsorship.Amount (any value,
+//     including "-"), sponsor emails (done when unsponsored or emails are set), not blocked,
+//     not delayed
+//   - Work In Progress: Code, Head, Screen, RelatedVideos, Thumbnails, Diagrams, Screenshots,
+//     Location, Tagline, TaglineIdeas, OtherLogos
+//   - Definition: Title, Description, Tags, DescriptionTags, Tweet, Animations, RequestThumbnail
+//   - Post-Production: Thumbnail, Members, RequestEdit, Movie, Slides, Timecodes (done when set
+//     without a FIXME)
+//   - Publishing Details: UploadVideo, HugoPath
+//   - Post-Publish Details: DOTPosted, BlueSkyPosted, LinkedInPosted, SlackPosted,
+//     YouTubeHighlight, YouTubeComment, YouTubeCommentReply, GDE, Repo, NotifiedSponsors
+//     (done when notified or unsponsored)
+//
+// String fields count as done when non-empty and not "-"; boolean fields when true.
+func (v Video) PhaseProgress(phase string) (int, int) {
+	switch phase {
+	case constants.PhaseTitleInitialDetails:
+		emailsDone := !v.isSponsored() || len(v.Sponsorship.Emails) > 0
+		return countCompleted(v.ProjectName, v.ProjectURL, v.Gist, v.Date, len(v.Sponsorship.Amount) > 0,
+			emailsDone, len(v.Sponsorship.Blocked) == 0, !v.Delayed)
+	case constants.PhaseTitleWorkProgress:
+		return countCompleted(v.Code, v.Head, v.Screen, v.RelatedVideos, v.Thumbnails, v.Diagrams,
+			v.Screenshots, v.Location, v.Tagline, v.TaglineIdeas, v.OtherLogos)
+	case constants.PhaseTitleDefinition:
+		return countCompleted(strings.TrimSpace(v.Title), strings.TrimSpace(v.Description), strings.TrimSpace(v.Tags),
+			strings.TrimSpace(v.DescriptionTags), strings.TrimSpace(v.Tweet), strings.TrimSpace(v.Animations), v.RequestThumbnail)
+	case constants.PhaseTitlePostProduction:
+		timecodesDone := v.Timecodes != "" && !strings.Contains(v.Timecodes, "FIXME:")
+		return countCompleted(v.Thumbnail, v.Members, v.RequestEdit, v.Movie, v.Slides, timecodesDone)
+	case constants.PhaseTitlePublishingDetails:
+		return countCompleted(v.UploadVideo, v.HugoPath)
+	case constants.PhaseTitlePostPublish:
+		return countCompleted(v.DOTPosted, v.BlueSkyPosted, v.LinkedInPosted, v.SlackPosted, v.YouTubeHighlight,
+			v.YouTubeComment, v.YouTubeCommentReply, v.GDE, v.Repo, v.NotifiedSponsors || !v.isSponsored())
+	default:
+		return 0, 0
+	}
+}
+
+// isSponsored reports whether the sponsorship amount indicates an actual sponsor.
+func (v Video) isSponsored() bool {
+	amount := v.Sponsorship.Amount
+	return len(amount) > 0 && amount != "N/A" && amount != "-"
+}
+
+// countCompleted counts the fields that are done: non-empty strings other than "-" and true booleans.
+func countCompleted(fields ...interface{}) (completed int, total int) {
+	for _, field := range fields {
+		switch value := field.(type) {
+		case string:
+			if len(value) > 0 && value != "-" {
+				completed++
+			}
+		case bool:
+			if value {
+				completed++
+			}
+		}
+		total++
+	}
+	return completed, total
+}
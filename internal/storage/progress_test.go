@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+// completeVideo returns a video with every task in every phase done.
+func completeVideo() Video {
+	return Video{
+		ProjectName: "Crossplane", ProjectURL: "https://crossplane.io", Gist: "gist.md", Date: "2025-01-15T10:30",
+		Sponsorship: Sponsorship{Amount: "1000", Emails: "sponsor@example.com"},
+		Code:        true, Head: true, Screen: true, RelatedVideos: "abc", Thumbnails: true, Diagrams: true,
+		Screenshots: true, Location: "home", Tagline: "tagline", TaglineIdeas: "ideas", OtherLogos: "logos",
+		Title: "Title", Description: "Description", Tags: "tags", DescriptionTags: "#tags", Tweet: "tweet",
+		Animations: "animations", RequestThumbnail: true,
+		Thumbnail: "thumb.png", Members: "members", RequestEdit: true, Movie: true, Slides: true, Timecodes: "00:00 Intro",
+		UploadVideo: "video.mp4", HugoPath: "hugo.md",
+		DOTPosted: true, BlueSkyPosted: true, LinkedInPosted: true, SlackPosted: true, YouTubeHighlight: true,
+		YouTubeComment: true, YouTubeCommentReply: true, GDE: true, Repo: "repo", NotifiedSponsors: true,
+	}
+}
+
+func TestVideo_Progress_FullyDone(t *testing.T) {
+	video := completeVideo()
+
+	expectedTotals := map[string]int{
+		constants.PhaseTitleInitialDetails:    8,
+		constants.PhaseTitleWorkProgress:      11,
+		constants.PhaseTitleDefinition:        7,
+		constants.PhaseTitlePostProduction:    6,
+		constants.PhaseTitlePublishingDetails: 2,
+		constants.PhaseTitlePostPublish:       10,
+	}
+	for phase, expected := range expectedTotals {
+		completed, total := video.PhaseProgress(phase)
+		assert.Equal(t, expected, total, phase)
+		assert.Equal(t, expected, completed, phase)
+	}
+
+	completed, total := video.Progress()
+	assert.Equal(t, 44, total)
+	assert.Equal(t, 44, completed)
+}
+
+func TestVideo_Progress_HalfDone(t *testing.T) {
+	video := Video{
+		// Initial Details: 4 of 8 (unsponsored counts emails as done; not blocked; not delayed)
+		ProjectName: "Crossplane",
+		// Work Progress: 5 of 11
+		Code: true, Head: true, Screen: true, RelatedVideos: "-", Thumbnails: true, Location: "home",
+		// Definition: 3 of 7, whitespace-only doesn't count
+		Title: "Title", Description: "  ", Tags: "tags", RequestThumbnail: true,
+		// Post-Production: 2 of 6, a FIXME timecode isn't done
+		Thumbnail: "thumb.png", Movie: true, Timecodes: "FIXME: add timecodes",
+		// Publishing Details: 1 of 2
+		UploadVideo: "video.mp4",
+		// Post-Publish: 1 of 10 (unsponsored counts as notified)
+	}
+
+	tests := []struct {
+		phase     string
+		completed int
+		total     int
+	}{
+		{constants.PhaseTitleInitialDetails, 4, 8},
+		{constants.PhaseTitleWorkProgress, 5, 11},
+		{constants.PhaseTitleDefinition, 3, 7},
+		{constants.PhaseTitlePostProduction, 2, 6},
+		{constants.PhaseTitlePublishingDetails, 1, 2},
+		{constants.PhaseTitlePostPublish, 1, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.phase, func(t *testing.T) {
+			completed, total := video.PhaseProgress(tt.phase)
+			assert.Equal(t, tt.completed, completed)
+			assert.Equal(t, tt.total, total)
+		})
+	}
+
+	completed, total := video.Progress()
+	assert.Equal(t, 16, completed)
+	assert.Equal(t, 44, total)
+}
+
+func TestVideo_PhaseProgress_UnknownPhase(t *testing.T) {
+	completed, total := completeVideo().PhaseProgress("Unknown")
+	assert.Equal(t, 0, completed)
+	assert.Equal(t, 0, total)
+}
+
+func TestVideo_CurrentPhase(t *testing.T) {
+	full := completeVideo()
+	video := Video{}
+
+	steps := []struct {
+		fill     func(v *Video)
+		expected string
+	}{
+		{fill: func(v *Video) {}, expected: constants.PhaseTitleInitialDetails},
+		{fill: func(v *Video) {
+			v.ProjectName, v.ProjectURL, v.Gist, v.Date, v.Sponsorship = full.ProjectName, full.ProjectURL, full.Gist, full.Date, full.Sponsorship
+		}, expected: constants.PhaseTitleWorkProgress},
+		{fill: func(v *Video) {
+			v.Code, v.Head, v.Screen, v.RelatedVideos, v.Thumbnails, v.Diagrams = true, true, true, full.RelatedVideos, true, true
+			v.Screenshots, v.Location, v.Tagline, v.TaglineIdeas, v.OtherLogos = true, full.Location, full.Tagline, full.TaglineIdeas, full.OtherLogos
+		}, expected: constants.PhaseTitleDefinition},
+		{fill: func(v *Video) {
+			v.Title, v.Description, v.Tags, v.DescriptionTags = full.Title, full.Description, full.Tags, full.DescriptionTags
+			v.Tweet, v.Animations, v.RequestThumbnail = full.Tweet, full.Animations, true
+		}, expected: constants.PhaseTitlePostProduction},
+		{fill: func(v *Video) {
+			v.Thumbnail, v.Members, v.RequestEdit, v.Movie, v.Slides, v.Timecodes = full.Thumbnail, full.Members, true, true, true, full.Timecodes
+		}, expected: constants.PhaseTitlePublishingDetails},
+		{fill: func(v *Video) {
+			v.UploadVideo, v.HugoPath = full.UploadVideo, full.HugoPath
+		}, expected: constants.PhaseTitlePostPublish},
+		{fill: func(v *Video) {
+			v.DOTPosted, v.BlueSkyPosted, v.LinkedInPosted, v.SlackPosted, v.YouTubeHighlight = true, true, true, true, true
+			v.YouTubeComment, v.YouTubeCommentReply, v.GDE, v.Repo, v.NotifiedSponsors = true, true, true, full.Repo, true
+		}, expected: ""},
+	}
+
+	for i, step := range steps {
+		step.fill(&video)
+		assert.Equal(t, step.expected, video.CurrentPhase(), "step %d", i)
+		assert.Equal(t, step.expected == "", video.IsComplete(), "step %d", i)
+	}
+}
+
+func TestVideo_CurrentPhase_EarlierGapWins(t *testing.T) {
+	video := completeVideo()
+	video.Delayed = true
+
+	assert.Equal(t, constants.PhaseTitleInitialDetails, video.CurrentPhase())
+	assert.False(t, video.IsComplete())
+}
@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// blockedValues maps the boolean-like spellings accepted in Sponsorship.Blocked to their meaning.
+// "-" and "N/A" are the placeholders the menu already renders as blocked.
+var blockedValues = map[string]bool{
+	"true":  true,
+	"yes":   true,
+	"y":     true,
+	"1":     true,
+	"on":    true,
+	"-":     true,
+	"n/a":   true,
+	"false": false,
+	"no":    false,
+	"n":     false,
+	"0":     false,
+	"off":   false,
+}
+
+// IsBlocked reports whether the sponsorship is blocked. Blocked is stored as a string so
+// existing YAML keeps working: empty and falsey values ("false", "no", "0", ...) mean not
+// blocked, truthy values mean blocked, and any other text is treated as a block reason.
+func (s Sponsorship) IsBlocked() bool {
+	value := strings.ToLower(strings.TrimSpace(s.Blocked))
+	if value == "" {
+		return false
+	}
+	if blocked, ok := blockedValues[value]; ok {
+		return blocked
+	}
+	return true
+}
+
+// Validate flags Blocked values that are neither a recognized boolean nor a readable
+// reason, such as stray punctuation or numbers, since their intent is ambiguous.
+func (s Sponsorship) Validate() error {
+	value := strings.ToLower(strings.TrimSpace(s.Blocked))
+	if value == "" {
+		return nil
+	}
+	if _, ok := blockedValues[value]; ok {
+		return nil
+	}
+	if !strings.ContainsFunc(value, unicode.IsLetter) {
+		return fmt.Errorf("ambiguous sponsorship blocked value %q: use true/false or a reason", s.Blocked)
+	}
+	return nil
+}
+
+// EmailList splits the comma-separated Emails field into individual addresses,
+// trimming whitespace and dropping empty entries and duplicates (case-insensitively).
+func (s Sponsorship) EmailList() []string {
+	var emails []string
+	seen := make(map[string]bool)
+	for _, email := range strings.Split(s.Emails, ",") {
+		email = strings.TrimSpace(email)
+		if email == "" || seen[strings.ToLower(email)] {
+			continue
+		}
+		seen[strings.ToLower(email)] = true
+		emails = append(emails, email)
+	}
+	return emails
+}
+
+// RedactedEmails returns EmailList with every address masked by RedactEmail, for logging.
+func (s Sponsorship) RedactedEmails() []string {
+	emails := s.EmailList()
+	for i, email := range emails {
+		emails[i] = RedactEmail(email)
+	}
+	return emails
+}
+
+// RedactEmail masks an email address so it can be logged, keeping only the first character of
+// the local part and the domain: "sponsor@example.com" becomes "s***@example.com". Text without
+// a domain is masked entirely and an empty address stays empty.
+func RedactEmail(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if addr == "" {
+		return ""
+	}
+	at := strings.LastIndex(addr, "@")
+	if at < 0 {
+		return "***"
+	}
+	local, domain := addr[:at], addr[at+1:]
+	if local == "" {
+		return "***@" + domain
+	}
+	first, _ := utf8.DecodeRuneInString(local)
+	return string(first) + "***@" + domain
+}
+
+// ValidateEmails checks that every address in EmailList parses as an RFC 5322 address,
+// returning an error listing each invalid one by its position in the list, redacted by
+// RedactEmail.
+func (s Sponsorship) ValidateEmails() error {
+	var errs []error
+	for i, email := range s.EmailList() {
+		if _, err := mail.ParseAddress(email); err != nil {
+			errs = append(errs, fmt.Errorf("invalid sponsor email #%d %q: %w", i+1, RedactEmail(email), err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSponsorship_IsBlocked(t *testing.T) {
+	tests := []struct {
+		name        string
+		blocked     string
+		expected    bool
+		expectError bool
+	}{
+		{name: "true", blocked: "true", expected: true},
+		{name: "false", blocked: "false", expected: false},
+		{name: "empty", blocked: "", expected: false},
+		{name: "yes", blocked: "yes", expected: true},
+		{name: "mixed case no", blocked: " No ", expected: false},
+		{name: "zero", blocked: "0", expected: false},
+		{name: "placeholder", blocked: "N/A", expected: true},
+		{name: "reason", blocked: "Legal review pending", expected: true},
+		{name: "garbage", blocked: "?!?", expected: true, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Sponsorship{Blocked: tt.blocked}
+			assert.Equal(t, tt.expected, s.IsBlocked())
+			if tt.expectError {
+				assert.Error(t, s.Validate())
+			} else {
+				assert.NoError(t, s.Validate())
+			}
+		})
+	}
+}
+
+func TestSponsorship_EmailList(t *testing.T) {
+	tests := []struct {
+		name     string
+		emails   string
+		expected []string
+	}{
+		{name: "empty", emails: "", expected: nil},
+		{name: "single", emails: "a@example.com", expected: []string{"a@example.com"}},
+		{name: "trailing comma", emails: "a@example.com,", expected: []string{"a@example.com"}},
+		{name: "spaces", emails: " a@example.com ,  b@example.com ", expected: []string{"a@example.com", "b@example.com"}},
+		{name: "duplicates", emails: "a@example.com, b@example.com, A@example.com", expected: []string{"a@example.com", "b@example.com"}},
+		{name: "only separators", emails: " , ,", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Sponsorship{Emails: tt.emails}.EmailList())
+		})
+	}
+}
+
+func TestRedactEmail(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     string
+		expected string
+	}{
+		{name: "normal address", addr: "sponsor@example.com", expected: "s***@example.com"},
+		{name: "surrounding spaces", addr: " sponsor@example.com ", expected: "s***@example.com"},
+		{name: "multi-byte first character", addr: "élodie@example.fr", expected: "é***@example.fr"},
+		{name: "no local part", addr: "@example.com", expected: "***@example.com"},
+		{name: "no domain", addr: "sponsor", expected: "***"},
+		{name: "empty", addr: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, RedactEmail(tt.addr))
+		})
+	}
+}
+
+func TestSponsorship_RedactedEmails(t *testing.T) {
+	sponsorship := Sponsorship{Emails: "a@example.com, bob@example.org"}
+	assert.Equal(t, []string{"a***@example.com", "b***@example.org"}, sponsorship.RedactedEmails())
+	assert.Nil(t, Sponsorship{}.RedactedEmails())
+}
+
+func TestSponsorship_ValidateEmails(t *testing.T) {
+	assert.NoError(t, Sponsorship{Emails: "a@example.com, b@example.com,"}.ValidateEmails())
+	assert.NoError(t, Sponsorship{}.ValidateEmails())
+
+	err := Sponsorship{Emails: "a@example.com, not-an-email, bob@example@com"}.ValidateEmails()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid sponsor email #2 "***"`)
+	assert.Contains(t, err.Error(), `invalid sponsor email #3 "b***@com"`)
+	assert.NotContains(t, err.Error(), "not-an-email")
+	assert.NotContains(t, err.Error(), "a@example.com")
+}
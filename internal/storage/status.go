@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// VideoStatus summarizes the computed state of a video for integrations.
+type VideoStatus struct {
+	CurrentPhase     string         `json:"currentPhase"` // Empty once every phase is complete
+	Progress         StatusProgress `json:"progress"`
+	LanguageDrift    bool           `json:"languageDrift"`
+	ValidationErrors []string       `json:"validationErrors"`
+}
+
+// StatusProgress is the number of completed and total tasks across all phases.
+type StatusProgress struct {
+	Completed int `json:"completed"`
+	Total     int `json:"total"`
+}
+
+// Status returns the video's current phase, progress, language drift and validation errors.
+func (v Video) Status() VideoStatus {
+	completed, total := v.Progress()
+	return VideoStatus{
+		CurrentPhase:     v.CurrentPhase(),
+		Progress:         StatusProgress{Completed: completed, Total: total},
+		LanguageDrift:    v.LanguageDrift(),
+		ValidationErrors: v.validationMessages(),
+	}
+}
+
+// StatusJSON returns Status encoded as JSON. validationErrors is an empty array, never null,
+// when the video is valid.
+func (v Video) StatusJSON() ([]byte, error) {
+	return json.Marshal(v.Status())
+}
+
+// validationMessages returns the messages of validationErrors, an empty slice when there are none.
+func (v Video) validationMessages() []string {
+	messages := []string{}
+	for _, err := range v.validationErrors() {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
+
+// ChannelSummary tallies the status of every indexed video, such as for a dashboard.
+type ChannelSummary struct {
+	Total         int            `json:"total"`
+	Phases        map[string]int `json:"phases"`    // Videos per current phase, IndexPhaseComplete for finished ones
+	Published     int            `json:"published"` // Videos uploaded to YouTube, which have a VideoId
+	LanguageDrift int            `json:"languageDrift"`
+}
+
+// AggregateStatus loads every indexed video, one at a time, and tallies how many are in each
+// phase, how many are published and how many have language drift. Like GetAllVideos, videos
+// that fail to load are left out of the tallies and their errors combined into the returned
+// error alongside the summary of the rest.
+func (y *YAML) AggregateStatus() (ChannelSummary, error) {
+	summary := ChannelSummary{Phases: make(map[string]int)}
+	err := y.EachVideo(func(video Video) error {
+		summary.Total++
+		summary.Phases[indexPhase(video)]++
+		if strings.TrimSpace(video.VideoId) != "" {
+			summary.Published++
+		}
+		if video.LanguageDrift() {
+			summary.LanguageDrift++
+		}
+		return nil
+	})
+	return summary, err
+}
@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVideo_StatusJSON_PartiallyComplete(t *testing.T) {
+	video := completeVideo()
+	video.Name, video.Path, video.Category = "video", "manuscript/devops/video.yaml", "devops"
+	video.Repo = "https://github.com/vfarcic/demo"
+	video.Movie, video.Slides = false, false
+	video.VideoId, video.Language, video.AppliedLanguage = "dQw4w9WgXcQ", "es", "en"
+	video.Sponsorship.Emails = "sponsor@example.com, not-an-email"
+
+	data, err := video.StatusJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"currentPhase": "Post-Production",
+		"progress": {"completed": 42, "total": 44},
+		"languageDrift": true,
+		"validationErrors": ["invalid sponsor email #2 \"***\": mail: missing '@' or angle-addr"]
+	}`, string(data))
+}
+
+func TestVideo_StatusJSON_EmptyOptionalFields(t *testing.T) {
+	video := Video{Name: "video", Path: "manuscript/devops/video.yaml", Category: "devops"}
+
+	data, err := video.StatusJSON()
+	require.NoError(t, err)
+
+	var status map[string]any
+	require.NoError(t, json.Unmarshal(data, &status))
+	assert.Equal(t, "Initial Details", status["currentPhase"])
+	assert.Equal(t, false, status["languageDrift"])
+	assert.Equal(t, []any{}, status["validationErrors"], "a valid video must report an empty array, not null")
+}
+
+func TestVideo_Status_ReportsEachValidationError(t *testing.T) {
+	video := Video{Date: "tomorrow", Title: "<b>", ProjectURL: "ftp://example.com", Members: "a|b"}
+
+	errs := video.Status().ValidationErrors
+	assert.Len(t, errs, 5)
+	assert.Contains(t, errs, "missing required fields: Name, Path, Category")
+}
+
+func TestYAML_AggregateStatus(t *testing.T) {
+	published := completeVideo()
+	published.Name, published.VideoId = "published", "abc123"
+	drifted := Video{Name: "drifted", VideoId: "def456", Language: "es", AppliedLanguage: "en"}
+
+	index := []VideoIndex{
+		{Name: "published", Category: "testing"},
+		{Name: "drifted", Category: "testing"},
+		{Name: "new", Category: "testing"},
+		{Name: "missing", Category: "testing"},
+	}
+	y := setupVideoFixtures(t, index, map[string]Video{
+		"published": published,
+		"drifted":   drifted,
+		"new":       {Name: "new"},
+	})
+
+	summary, err := y.AggregateStatus()
+	require.Error(t, err, "videos that fail to load are reported")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	assert.Equal(t, 3, summary.Total)
+	assert.Equal(t, map[string]int{IndexPhaseComplete: 1, constants.PhaseTitleInitialDetails: 2}, summary.Phases)
+	assert.Equal(t, 2, summary.Published)
+	assert.Equal(t, 1, summary.LanguageDrift)
+}
+
+func TestYAML_AggregateStatus_MissingIndex(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+
+	summary, err := y.AggregateStatus()
+	assert.Error(t, err)
+	assert.Zero(t, summary.Total)
+}
@@ -0,0 +1,14 @@
+package storage
+
+// Store reads and writes video metadata and the video index. YAML, which keeps them in files
+// on local disk, is the default implementation; others can keep them elsewhere, such as in
+// object storage, without changing the code using them.
+type Store interface {
+	GetVideo(path string) (Video, error)
+	WriteVideo(video Video, path string) error
+	GetIndex() ([]VideoIndex, error)
+	WriteIndex(index []VideoIndex) error
+}
+
+// YAML must keep satisfying Store
+var _ Store = (*YAML)(nil)
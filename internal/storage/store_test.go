@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setNow makes WriteVideo stamp videos with at for the duration of a test.
+func setNow(t *testing.T, at time.Time) {
+	t.Helper()
+	original := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() {
+		now = original
+	})
+}
+
+// testStoreRoundTrip checks the behavior every Store implementation must provide, using
+// videoPath as the location of a video that doesn't exist yet.
+func testStoreRoundTrip(t *testing.T, store Store, videoPath string) {
+	t.Helper()
+
+	_, err := store.GetVideo(videoPath)
+	assert.Error(t, err, "reading a missing video must fail")
+
+	created := time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+	setNow(t, created)
+	video := Video{Name: "Video", Category: "testing", Title: "Title", Tags: "Kubernetes"}
+	require.NoError(t, store.WriteVideo(video, videoPath))
+	read, err := store.GetVideo(videoPath)
+	require.NoError(t, err)
+	assert.Equal(t, video.Name, read.Name)
+	assert.Equal(t, video.Title, read.Title)
+	assert.Equal(t, video.Tags, read.Tags)
+	assert.True(t, read.CreatedAt.Equal(created), "a new video is created when first written")
+	assert.True(t, read.UpdatedAt.Equal(created))
+
+	updated := created.Add(90 * time.Minute)
+	setNow(t, updated)
+	video.Title = "New title"
+	require.NoError(t, store.WriteVideo(video, videoPath))
+	read, err = store.GetVideo(videoPath)
+	require.NoError(t, err)
+	assert.True(t, read.CreatedAt.Equal(created), "CreatedAt must be preserved across writes")
+	assert.True(t, read.UpdatedAt.Equal(updated), "UpdatedAt must be bumped when the video changes")
+
+	setNow(t, updated.Add(time.Hour))
+	require.NoError(t, store.WriteVideo(read, videoPath))
+	require.NoError(t, store.WriteVideo(video, videoPath))
+	read, err = store.GetVideo(videoPath)
+	require.NoError(t, err)
+	assert.True(t, read.UpdatedAt.Equal(updated), "UpdatedAt must not change when an unchanged video is saved")
+
+	index := []VideoIndex{{Name: "Video", Category: "testing"}, {Name: "Other", Category: "ai"}}
+	require.NoError(t, store.WriteIndex(index))
+	readIndex, err := store.GetIndex()
+	require.NoError(t, err)
+	assert.Equal(t, index, readIndex)
+}
+
+// testStoreMissingData checks that a Store reports a missing video and a missing index with
+// errors wrapping os.ErrNotExist, which callers use to tell them apart from other failures.
+func testStoreMissingData(t *testing.T, store Store, videoPath string) {
+	t.Helper()
+
+	_, err := store.GetVideo(videoPath)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	_, err = store.GetIndex()
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestYAML_Store(t *testing.T) {
+	dir := t.TempDir()
+	var store Store = NewYAML(filepath.Join(dir, "index.yaml"))
+
+	testStoreRoundTrip(t, store, filepath.Join(dir, "video.yaml"))
+}
+
+func TestYAML_Store_MissingData(t *testing.T) {
+	dir := t.TempDir()
+	var store Store = NewYAML(filepath.Join(dir, "index.yaml"))
+
+	testStoreMissingData(t, store, filepath.Join(dir, "missing.yaml"))
+}
+
+func TestYAML_Store_ReadErrors(t *testing.T) {
+	dir := t.TempDir()
+	indexPath, videoPath := filepath.Join(dir, "index.yaml"), filepath.Join(dir, "video.yaml")
+	var store Store = NewYAML(indexPath)
+	require.NoError(t, os.WriteFile(indexPath, []byte("[{invalid"), 0644))
+	require.NoError(t, os.WriteFile(videoPath, []byte("name: [invalid"), 0644))
+
+	_, err := store.GetIndex()
+	assert.ErrorContains(t, err, "failed to unmarshal video index")
+	assert.NotErrorIs(t, err, os.ErrNotExist, "a corrupt index is not a missing one")
+
+	_, err = store.GetVideo(videoPath)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, os.ErrNotExist, "a corrupt video is not a missing one")
+}
+
+func TestYAML_Store_WriteErrors(t *testing.T) {
+	// A regular file where a directory is expected makes every write under it fail
+	notDir := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(notDir, nil, 0644))
+	var store Store = NewYAML(filepath.Join(notDir, "index.yaml"))
+
+	assert.ErrorContains(t, store.WriteIndex([]VideoIndex{{Name: "Video", Category: "testing"}}), "failed to write")
+	assert.ErrorContains(t, store.WriteVideo(Video{Name: "Video"}, filepath.Join(notDir, "video.yaml")), "failed to write")
+
+	_, err := store.GetIndex()
+	assert.Error(t, err, "a failed write must not leave an index behind")
+}
@@ -0,0 +1,21 @@
+package storage
+
+import "strings"
+
+// NormalizeTags cleans up a comma-separated tag list: entries are trimmed, runs of whitespace
+// inside them collapse to a single space, empty entries are dropped, and duplicates are removed
+// case-insensitively, keeping the casing of the first occurrence. The result is joined with ", ".
+func NormalizeTags(raw string) string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, tag := range strings.Split(raw, ",") {
+		tag = strings.Join(strings.Fields(tag), " ")
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		tags = append(tags, tag)
+	}
+	return strings.Join(tags, ", ")
+}
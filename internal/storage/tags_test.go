@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{name: "Already normalized", raw: "Kubernetes, GitOps", expected: "Kubernetes, GitOps"},
+		{name: "Case-insensitive duplicates keep the first casing", raw: "Kubernetes,kubernetes ,KUBERNETES,Argo CD", expected: "Kubernetes, Argo CD"},
+		{name: "Whitespace is collapsed", raw: "  Argo \t  CD ,Cloud   Native\n", expected: "Argo CD, Cloud Native"},
+		{name: "Whitespace differences are duplicates", raw: "Argo CD,argo  cd", expected: "Argo CD"},
+		{name: "Empty entries are dropped", raw: ",Kubernetes,, ,GitOps,", expected: "Kubernetes, GitOps"},
+		{name: "Empty", raw: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeTags(tt.raw))
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MinChapters is the number of chapters YouTube requires before it shows them on a video.
+const MinChapters = 3
+
+// Chapter is a single timecode entry marking where a section of the video starts.
+type Chapter struct {
+	Start time.Duration
+	Title string
+}
+
+// timecodePattern matches a line such as "01:23 Title", "1:02:03 - Title" or "00:00 – Intro".
+var timecodePattern = regexp.MustCompile(`^((?:\d+:)?\d{1,2}:\d{2})\s*(?:[-–—]\s*)?(.*)$`)
+
+// ParseTimecodes parses one chapter per line in the form "mm:ss Title" or "hh:mm:ss Title".
+// Blank lines are ignored. It enforces YouTube's chapter rules: the first chapter starts at
+// 00:00, start times strictly increase, and there are at least MinChapters chapters.
+func ParseTimecodes(raw string) ([]Chapter, error) {
+	var chapters []Chapter
+	for i, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		match := timecodePattern.FindStringSubmatch(line)
+		if match == nil {
+			return nil, fmt.Errorf("line %d %q: expected a timecode such as 01:23 followed by a title", i+1, line)
+		}
+		start, err := parseTimecode(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d %q: %w", i+1, line, err)
+		}
+		title := strings.TrimSpace(match[2])
+		if title == "" {
+			return nil, fmt.Errorf("line %d %q: chapter title is missing", i+1, line)
+		}
+
+		switch {
+		case len(chapters) == 0 && start != 0:
+			return nil, fmt.Errorf("line %d %q: the first chapter must start at 00:00", i+1, line)
+		case len(chapters) > 0 && start <= chapters[len(chapters)-1].Start:
+			return nil, fmt.Errorf("line %d %q: chapter starts at or before the previous chapter (%s)", i+1, line, FormatTimecode(chapters[len(chapters)-1].Start))
+		}
+		chapters = append(chapters, Chapter{Start: start, Title: title})
+	}
+
+	if len(chapters) < MinChapters {
+		return nil, fmt.Errorf("found %d chapter(s), YouTube requires at least %d", len(chapters), MinChapters)
+	}
+	return chapters, nil
+}
+
+// parseTimecode converts "mm:ss" or "hh:mm:ss" to a duration. Minutes and seconds beyond the
+// first component must be below 60.
+func parseTimecode(value string) (time.Duration, error) {
+	parts := strings.Split(value, ":")
+	var total time.Duration
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("invalid timecode %s", value)
+		}
+		if i > 0 && n >= 60 {
+			return 0, fmt.Errorf("invalid timecode %s: minutes and seconds must be below 60", value)
+		}
+		total = total*60 + time.Duration(n)
+	}
+	return total * time.Second, nil
+}
+
+// FormatTimecode formats a duration as "mm:ss", or "h:mm:ss" from one hour on.
+func FormatTimecode(d time.Duration) string {
+	seconds := int(d / time.Second)
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
+
+// ValidateTimecodes checks that Timecodes can be published as YouTube chapters with
+// ParseTimecodes. Unset timecodes, empty or "N/A", are valid since chapters are optional.
+func (v Video) ValidateTimecodes() error {
+	timecodes := strings.TrimSpace(v.Timecodes)
+	if timecodes == "" || timecodes == "N/A" {
+		return nil
+	}
+	if _, err := ParseTimecodes(timecodes); err != nil {
+		return fmt.Errorf("invalid timecodes: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimecodes_Valid(t *testing.T) {
+	raw := `00:00 Introduction
+
+01:30 - Setting up the cluster
+12:05 – Deploying the app
+1:02:03 Wrap-up`
+
+	chapters, err := ParseTimecodes(raw)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Chapter{
+		{Start: 0, Title: "Introduction"},
+		{Start: 90 * time.Second, Title: "Setting up the cluster"},
+		{Start: 12*time.Minute + 5*time.Second, Title: "Deploying the app"},
+		{Start: time.Hour + 2*time.Minute + 3*time.Second, Title: "Wrap-up"},
+	}, chapters)
+}
+
+func TestParseTimecodes_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		errContains string
+	}{
+		{
+			name:        "non-zero first timestamp",
+			raw:         "00:05 Intro\n01:00 Middle\n02:00 End",
+			errContains: "line 1 \"00:05 Intro\": the first chapter must start at 00:00",
+		},
+		{
+			name:        "out of order",
+			raw:         "00:00 Intro\n02:00 Middle\n01:00 End",
+			errContains: "line 3 \"01:00 End\": chapter starts at or before the previous chapter (02:00)",
+		},
+		{
+			name:        "duplicate timestamp",
+			raw:         "00:00 Intro\n01:00 Middle\n01:00 End",
+			errContains: "at or before the previous chapter",
+		},
+		{
+			name:        "too few chapters",
+			raw:         "00:00 Intro\n01:00 End",
+			errContains: "found 2 chapter(s), YouTube requires at least 3",
+		},
+		{
+			name:        "placeholder timestamp",
+			raw:         "00:00 FIXME:\nFIXME:FIXME Section",
+			errContains: "line 2 \"FIXME:FIXME Section\": expected a timecode",
+		},
+		{
+			name:        "seconds out of range",
+			raw:         "00:00 Intro\n01:75 Middle\n02:00 End",
+			errContains: "minutes and seconds must be below 60",
+		},
+		{
+			name:        "missing title",
+			raw:         "00:00 Intro\n01:00\n02:00 End",
+			errContains: "line 2 \"01:00\": chapter title is missing",
+		},
+		{
+			name:        "empty",
+			raw:         "",
+			errContains: "found 0 chapter(s)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chapters, err := ParseTimecodes(tt.raw)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+			assert.Nil(t, chapters)
+		})
+	}
+}
+
+func TestFormatTimecode(t *testing.T) {
+	assert.Equal(t, "00:00", FormatTimecode(0))
+	assert.Equal(t, "09:05", FormatTimecode(9*time.Minute+5*time.Second))
+	assert.Equal(t, "1:02:03", FormatTimecode(time.Hour+2*time.Minute+3*time.Second))
+}
+
+func TestVideo_ValidateTimecodes(t *testing.T) {
+	assert.NoError(t, Video{}.ValidateTimecodes())
+	assert.NoError(t, Video{Timecodes: "N/A"}.ValidateTimecodes())
+	assert.NoError(t, Video{Timecodes: "00:00 Intro\n01:00 Setup\n05:00 Demo\n"}.ValidateTimecodes())
+
+	err := Video{Timecodes: "00:00 Intro\nFIXME"}.ValidateTimecodes()
+	assert.ErrorContains(t, err, "invalid timecodes: line 2")
+}
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxTitleLength is YouTube's limit on the number of characters in a video title.
+const MaxTitleLength = 100
+
+// ValidateTitle checks that Title is accepted by YouTube: not blank, at most MaxTitleLength
+// characters and without angle brackets.
+func (v Video) ValidateTitle() error {
+	if strings.TrimSpace(v.Title) == "" {
+		return fmt.Errorf("title is empty")
+	}
+	if length := utf8.RuneCountInString(v.Title); length > MaxTitleLength {
+		return fmt.Errorf("title is %d characters, exceeding the %d character limit", length, MaxTitleLength)
+	}
+	if strings.ContainsAny(v.Title, "<>") {
+		return fmt.Errorf("title %q contains angle brackets, which YouTube does not allow", v.Title)
+	}
+	return nil
+}
+
+// SanitizeTitle returns Title with angle brackets removed, surrounding whitespace trimmed and
+// cut to MaxTitleLength characters, so that it passes ValidateTitle unless nothing is left.
+func (v Video) SanitizeTitle() string {
+	title := strings.TrimSpace(strings.NewReplacer("<", "", ">", "").Replace(v.Title))
+	if utf8.RuneCountInString(title) > MaxTitleLength {
+		title = strings.TrimSpace(string([]rune(title)[:MaxTitleLength]))
+	}
+	return title
+}
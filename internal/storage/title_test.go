@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTitle(t *testing.T) {
+	tests := []struct {
+		name          string
+		title         string
+		expectedError string
+	}{
+		{name: "Valid title", title: "Kubernetes in 10 Minutes"},
+		{name: "Exactly at the limit", title: strings.Repeat("ü", MaxTitleLength)},
+		{name: "Over the limit", title: strings.Repeat("a", MaxTitleLength+1), expectedError: "title is 101 characters"},
+		{name: "Angle brackets", title: "Generics: List<T> Explained", expectedError: "angle brackets"},
+		{name: "Empty title", title: "", expectedError: "title is empty"},
+		{name: "Whitespace-only title", title: " \t\n", expectedError: "title is empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Video{Title: tt.title}.ValidateTitle()
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSanitizeTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{name: "Valid title is unchanged", title: "Kubernetes in 10 Minutes", expected: "Kubernetes in 10 Minutes"},
+		{name: "Angle brackets and whitespace", title: "  <Generics> List<T>  ", expected: "Generics ListT"},
+		{name: "Long title is cut", title: strings.Repeat("a", MaxTitleLength-1) + " bcd", expected: strings.Repeat("a", MaxTitleLength-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			video := Video{Title: tt.title}
+			sanitized := video.SanitizeTitle()
+			assert.Equal(t, tt.expected, sanitized)
+			video.Title = sanitized
+			assert.NoError(t, video.ValidateTitle())
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Limits X applies to posts
+const (
+	MaxTweetLength = 280
+	TweetURLLength = 23
+)
+
+// tweetURLPattern matches the links X shortens, plus the [YOUTUBE] placeholder that is replaced
+// by a link before posting.
+var tweetURLPattern = regexp.MustCompile(`https?://\S+|\[YOUTUBE\]`)
+
+// TweetLength returns the length of Tweet as X counts it: every link, whatever its length,
+// counts as TweetURLLength characters, Latin and most punctuation count as one character, and
+// everything else, such as CJK or an emoji (including its modifiers), counts as two.
+func (v Video) TweetLength() int {
+	urls := tweetURLPattern.FindAllStringIndex(v.Tweet, -1)
+	length := weightedTweetLength(tweetURLPattern.ReplaceAllString(v.Tweet, ""))
+	return length + len(urls)*TweetURLLength
+}
+
+// tweetSingleWeightRanges are the code points X counts as one character.
+var tweetSingleWeightRanges = []struct{ first, last rune }{
+	{0x0000, 0x10FF},
+	{0x2000, 0x200D},
+	{0x2010, 0x201F},
+	{0x2032, 0x2037},
+}
+
+// weightedTweetLength counts text with X's weights. An emoji sequence counts once, so variation
+// selectors, skin tones, the second half of a flag and anything joined with a zero width joiner
+// add nothing to the emoji before them.
+func weightedTweetLength(text string) int {
+	length := 0
+	var previous rune
+	joined, flagOpen := false, false
+	for _, r := range text {
+		isFlagHalf := r >= 0x1F1E6 && r <= 0x1F1FF
+		switch {
+		case r == 0xFE0F || (r >= 0x1F3FB && r <= 0x1F3FF && tweetRuneWeight(previous) == 2):
+		case r == 0x200D && tweetRuneWeight(previous) == 2:
+			joined = true
+		case joined:
+			joined = false
+		case isFlagHalf && flagOpen:
+			flagOpen = false
+		default:
+			flagOpen = isFlagHalf
+			length += tweetRuneWeight(r)
+		}
+		previous = r
+	}
+	return length
+}
+
+// tweetRuneWeight returns how many characters X counts r as.
+func tweetRuneWeight(r rune) int {
+	for _, single := range tweetSingleWeightRanges {
+		if r >= single.first && r <= single.last {
+			return 1
+		}
+	}
+	return 2
+}
+
+// ValidateTweet checks that Tweet fits in a post without being truncated by X.
+func (v Video) ValidateTweet() error {
+	if length := v.TweetLength(); length > MaxTweetLength {
+		return fmt.Errorf("tweet is %d characters long, %d over the %d character limit", length, length-MaxTweetLength, MaxTweetLength)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTweet(t *testing.T) {
+	tests := []struct {
+		name           string
+		tweet          string
+		expectedLength int
+		expectError    bool
+	}{
+		{
+			name:           "Short tweet",
+			tweet:          "New video is out!",
+			expectedLength: 17,
+		},
+		{
+			name:           "Exactly at the limit",
+			tweet:          strings.Repeat("a", MaxTweetLength),
+			expectedLength: MaxTweetLength,
+		},
+		{
+			name:           "Accented characters count once",
+			tweet:          "Café déjà vu",
+			expectedLength: 12,
+		},
+		{
+			name:           "Emoji count twice",
+			tweet:          "Kubernetes 🚀 ☸",
+			expectedLength: 16,
+		},
+		{
+			name:           "Emoji sequences count as one emoji",
+			tweet:          "👍🏽 ❤️ 👩‍💻 🇩🇪",
+			expectedLength: 4*2 + 3,
+		},
+		{
+			name:           "CJK characters count twice",
+			tweet:          "新しい動画",
+			expectedLength: 10,
+		},
+		{
+			name:           "CJK at the limit",
+			tweet:          strings.Repeat("動", MaxTweetLength/2),
+			expectedLength: MaxTweetLength,
+		},
+		{
+			name:           "Placeholder counts as a link",
+			tweet:          "Watch it [YOUTUBE]",
+			expectedLength: 9 + TweetURLLength,
+		},
+		{
+			name:           "Links at the limit",
+			tweet:          strings.Repeat("a", MaxTweetLength-2*TweetURLLength-2) + " https://youtu.be/dQw4w9WgXcQ?si=a-very-long-tracking-parameter-value https://devopstoolkit.live",
+			expectedLength: MaxTweetLength,
+		},
+		{
+			name:           "Emoji push it over",
+			tweet:          strings.Repeat("a", MaxTweetLength-1) + "🚀",
+			expectedLength: MaxTweetLength + 1,
+			expectError:    true,
+		},
+		{
+			name:           "Links push it over",
+			tweet:          strings.Repeat("a", MaxTweetLength-2*TweetURLLength-1) + " https://youtu.be/dQw4w9WgXcQ [YOUTUBE]",
+			expectedLength: MaxTweetLength + 1,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			video := Video{Tweet: tt.tweet}
+			assert.Equal(t, tt.expectedLength, video.TweetLength())
+			if tt.expectError {
+				assert.ErrorContains(t, video.ValidateTweet(), "1 over the 280 character limit")
+			} else {
+				assert.NoError(t, video.ValidateTweet())
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"devopstoolkit/youtube-automation/internal/constants"
+)
+
+// PublishDateLayout is the layout of Video.Date (YYYY-MM-DDTHH:MM).
+const PublishDateLayout = "2006-01-02T15:04"
+
+// MissingRequiredFields returns the names of required fields (Name, Path, Category) that are empty.
+func (v Video) MissingRequiredFields() []string {
+	var missing []string
+	if strings.TrimSpace(v.Name) == "" {
+		missing = append(missing, "Name")
+	}
+	if strings.TrimSpace(v.Path) == "" {
+		missing = append(missing, "Path")
+	}
+	if strings.TrimSpace(v.Category) == "" {
+		missing = append(missing, "Category")
+	}
+	return missing
+}
+
+// Validate checks that the video metadata is complete enough to be processed.
+func (v Video) Validate() error {
+	if missing := v.MissingRequiredFields(); len(missing) > 0 {
+		return fmt.Errorf("missing required fields: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// ParsedPublishDate parses the publish date using PublishDateLayout.
+// An empty date means the video isn't scheduled yet and returns the zero time without an error.
+func (v Video) ParsedPublishDate() (time.Time, error) {
+	if strings.TrimSpace(v.Date) == "" {
+		return time.Time{}, nil
+	}
+	date, err := time.Parse(PublishDateLayout, strings.TrimSpace(v.Date))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid publish date %q, expected format YYYY-MM-DDTHH:MM: %w", v.Date, err)
+	}
+	return date, nil
+}
+
+// ValidateURLs checks that ProjectURL and Repo are absolute http(s) URLs, returning one error
+// per offending field. Empty values and the "N/A" and "-" placeholders are allowed.
+func (v Video) ValidateURLs() []error {
+	var errs []error
+	for _, field := range []struct{ name, value string }{
+		{"ProjectURL", v.ProjectURL},
+		{"Repo", v.Repo},
+	} {
+		if err := validateURL(field.value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", field.name, err))
+		}
+	}
+	return errs
+}
+
+// validateURL reports why value isn't an absolute http(s) URL, or nil if it is or is unset.
+func validateURL(value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "N/A" || value == "-" {
+		return nil
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q: %w", value, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid URL %q: must start with http:// or https://", value)
+	}
+	if u.Host == "" || strings.ContainsAny(u.Host, " \t") {
+		return fmt.Errorf("invalid URL %q: missing or malformed host", value)
+	}
+	if strings.ContainsAny(value, " \t\n") {
+		return fmt.Errorf("invalid URL %q: contains whitespace", value)
+	}
+	return nil
+}
+
+// ValidateGist checks that Gist points to a readable markdown (.md) file. An empty Gist is
+// valid since the field is optional.
+func (v Video) ValidateGist() error {
+	path := strings.TrimSpace(v.Gist)
+	if path == "" {
+		return nil
+	}
+	if !strings.EqualFold(filepath.Ext(path), ".md") {
+		return fmt.Errorf("gist %s must be a markdown (.md) file", path)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("gist %s is not readable: %w", path, err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("gist %s is not readable: %w", path, err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("gist %s is a directory, not a file", path)
+	}
+	return nil
+}
+
+// GetVideoValidated reads a video like GetVideo and additionally validates its contents,
+// returning a descriptive error when the file is structurally valid but semantically incomplete.
+func (y *YAML) GetVideoValidated(path string) (Video, error) {
+	video, err := y.GetVideo(path)
+	if err != nil {
+		return video, err
+	}
+	if err := video.Validate(); err != nil {
+		return video, fmt.Errorf("invalid video data in %s: %w", path, err)
+	}
+	if _, err := video.ParsedPublishDate(); err != nil {
+		return video, fmt.Errorf("invalid video data in %s: %w", path, err)
+	}
+	if errs := video.ValidateURLs(); len(errs) > 0 {
+		return video, fmt.Errorf("invalid video data in %s: %w", path, errors.Join(errs...))
+	}
+	return video, nil
+}
+
+// LanguageDrift reports whether Language or AudioLanguage was changed after the video was
+// uploaded, so the languages set on YouTube (AppliedLanguage, AppliedAudioLanguage) are stale.
+// Only uploaded videos can drift. An unset or invalid language isn't drift: it falls back to
+// the default when applied, so updating the video on YouTube wouldn't change anything.
+func (v Video) LanguageDrift() bool {
+	if strings.TrimSpace(v.VideoId) == "" {
+		return false
+	}
+	return languageDrift(v.Language, v.AppliedLanguage) || languageDrift(v.AudioLanguage, v.AppliedAudioLanguage)
+}
+
+func languageDrift(language, applied string) bool {
+	language = constants.NormalizeLanguage(language)
+	if language == "" || applied == "" || !constants.IsValidLanguage(language) {
+		return false
+	}
+	return language != constants.NormalizeLanguage(applied)
+}
+
+// ValidateLanguages checks that Language and AudioLanguage, when set, are valid language codes.
+func (v Video) ValidateLanguages() error {
+	var errs []error
+	for _, field := range []struct{ name, value string }{
+		{"Language", v.Language},
+		{"AudioLanguage", v.AudioLanguage},
+	} {
+		code := constants.NormalizeLanguage(field.value)
+		if code != "" && !constants.IsValidLanguage(code) {
+			errs = append(errs, fmt.Errorf("%s: invalid language code %q", field.name, field.value))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validationErrors runs the checks that only look at the video itself and returns one error
+// per problem found. ValidateGist is left out since it reads the gist from disk.
+func (v Video) validationErrors() []error {
+	errs := []error{v.Validate()}
+	if _, err := v.ParsedPublishDate(); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, v.ValidateURLs()...)
+	// A title that isn't written yet is only an error once the video is uploaded
+	if v.Title != "" {
+		errs = append(errs, v.ValidateTitle())
+	}
+	errs = append(errs, v.ValidateTweet(), v.ValidateMembers(), v.Sponsorship.Validate(), v.Sponsorship.ValidateEmails())
+	return splitErrors(errs)
+}
+
+// splitErrors drops the nil errors and replaces joined ones, such as one per invalid email,
+// with the errors they join.
+func splitErrors(errs []error) []error {
+	var split []error
+	for _, err := range errs {
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			split = append(split, splitErrors(joined.Unwrap())...)
+		} else if err != nil {
+			split = append(split, err)
+		}
+	}
+	return split
+}
+
+// ValidateAll loads every indexed video and runs all the validators on it: required fields,
+// publish date, URLs, title, tweet, members, sponsorship, gist, languages and timecodes. It
+// returns the errors found per video path, including the error of a video that failed to load,
+// and keeps going past failing videos. Valid videos are left out, so an empty map means
+// everything is valid. A missing or unreadable index is reported under the index path.
+func (y *YAML) ValidateAll() map[string][]error {
+	problems := make(map[string][]error)
+	index, err := y.GetIndex()
+	if err != nil {
+		problems[y.IndexPath] = []error{err}
+		return problems
+	}
+
+	for _, vi := range index {
+		path := y.VideoPath(vi)
+		video, err := y.loadIndexedVideo(vi)
+		if err != nil {
+			problems[path] = []error{err}
+			continue
+		}
+		errs := video.validationErrors()
+		errs = append(errs, splitErrors([]error{video.ValidateGist(), video.ValidateLanguages(), video.ValidateTimecodes()})...)
+		if len(errs) > 0 {
+			problems[path] = errs
+		}
+	}
+	return problems
+}
@@ -0,0 +1,295 @@
+package storage
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVideoValidated(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectError   bool
+		errorContains []string
+	}{
+		{
+			name:        "Fully populated video",
+			content:     "name: Test Video\npath: /path/to/video.yaml\ncategory: testing\n",
+			expectError: false,
+		},
+		{
+			name:          "Missing name",
+			content:       "path: /path/to/video.yaml\ncategory: testing\n",
+			expectError:   true,
+			errorContains: []string{"missing required fields", "Name"},
+		},
+		{
+			name:          "Malformed publish date",
+			content:       "name: Test Video\npath: /path/to/video.yaml\ncategory: testing\ndate: 2025-01-15 10:30\n",
+			expectError:   true,
+			errorContains: []string{"invalid publish date", "2025-01-15 10:30"},
+		},
+		{
+			name:          "Scheme-less project URL",
+			content:       "name: Test Video\npath: /path/to/video.yaml\ncategory: testing\nprojecturl: example.com\n",
+			expectError:   true,
+			errorContains: []string{"ProjectURL", "must start with http:// or https://"},
+		},
+		{
+			name:          "Missing everything",
+			content:       "title: Only a title\n",
+			expectError:   true,
+			errorContains: []string{"Name, Path, Category"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "video.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0644))
+
+			y := YAML{}
+			video, err := y.GetVideoValidated(path)
+			if tt.expectError {
+				require.Error(t, err)
+				for _, fragment := range tt.errorContains {
+					assert.Contains(t, err.Error(), fragment)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Test Video", video.Name)
+		})
+	}
+}
+
+func TestGetVideoValidated_KeepsGetVideoBehavior(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("title: Incomplete\n"), 0644))
+
+	y := YAML{}
+	video, err := y.GetVideo(path)
+	require.NoError(t, err, "GetVideo should not validate required fields")
+	assert.Equal(t, "Incomplete", video.Title)
+
+	_, err = y.GetVideoValidated(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestParsedPublishDate(t *testing.T) {
+	tests := []struct {
+		name        string
+		date        string
+		expected    time.Time
+		expectError bool
+	}{
+		{
+			name:     "Correct date",
+			date:     "2025-01-15T10:30",
+			expected: time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:        "Wrong separator",
+			date:        "2025/01/15T10:30",
+			expectError: true,
+		},
+		{
+			name:        "Space instead of T",
+			date:        "2025-01-15 10:30",
+			expectError: true,
+		},
+		{
+			name:     "Empty value is not scheduled yet",
+			date:     "",
+			expected: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, err := Video{Date: tt.date}.ParsedPublishDate()
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "YYYY-MM-DDTHH:MM")
+				assert.True(t, date.IsZero())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, date)
+		})
+	}
+}
+
+func TestValidateURLs(t *testing.T) {
+	tests := []struct {
+		name           string
+		video          Video
+		expectedFields []string
+	}{
+		{name: "Valid URLs", video: Video{ProjectURL: "https://crossplane.io", Repo: "http://github.com/vfarcic/demo"}},
+		{name: "Empty values are allowed", video: Video{}},
+		{name: "Placeholders are allowed", video: Video{ProjectURL: "N/A", Repo: "-"}},
+		{name: "Scheme-less URL", video: Video{ProjectURL: "crossplane.io"}, expectedFields: []string{"ProjectURL"}},
+		{name: "Trailing text", video: Video{Repo: "https://github.com/vfarcic/demo (see README)"}, expectedFields: []string{"Repo"}},
+		{name: "Unsupported scheme", video: Video{Repo: "git@github.com:vfarcic/demo.git"}, expectedFields: []string{"Repo"}},
+		{name: "Both invalid", video: Video{ProjectURL: "ftp://example.com", Repo: "https://"}, expectedFields: []string{"ProjectURL", "Repo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.video.ValidateURLs()
+			require.Len(t, errs, len(tt.expectedFields))
+			for i, field := range tt.expectedFields {
+				assert.Contains(t, errs[i].Error(), field+": invalid URL")
+			}
+		})
+	}
+}
+
+func TestValidateGist(t *testing.T) {
+	dir := t.TempDir()
+	gist := filepath.Join(dir, "gist.md")
+	require.NoError(t, os.WriteFile(gist, []byte("# Gist\n"), 0644))
+	upper := filepath.Join(dir, "NOTES.MD")
+	require.NoError(t, os.WriteFile(upper, []byte("# Notes\n"), 0644))
+	text := filepath.Join(dir, "gist.txt")
+	require.NoError(t, os.WriteFile(text, []byte("text"), 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "folder.md"), 0755))
+
+	tests := []struct {
+		name        string
+		gist        string
+		errContains string
+	}{
+		{name: "Present file", gist: gist},
+		{name: "Upper-case extension", gist: upper},
+		{name: "Empty is optional", gist: ""},
+		{name: "Missing file", gist: filepath.Join(dir, "missing.md"), errContains: "not readable"},
+		{name: "Wrong extension", gist: text, errContains: "must be a markdown (.md) file"},
+		{name: "Directory", gist: filepath.Join(dir, "folder.md"), errContains: "is a directory"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Video{Gist: tt.gist}.ValidateGist()
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestLanguageDrift(t *testing.T) {
+	tests := []struct {
+		name     string
+		video    Video
+		expected bool
+	}{
+		{
+			name:     "Applied languages match",
+			video:    Video{VideoId: "dQw4w9WgXcQ", Language: "en", AppliedLanguage: "en", AudioLanguage: "es", AppliedAudioLanguage: "es"},
+			expected: false,
+		},
+		{
+			name:     "Language changed after upload",
+			video:    Video{VideoId: "dQw4w9WgXcQ", Language: "fr", AppliedLanguage: "en"},
+			expected: true,
+		},
+		{
+			name:     "Audio language changed after upload",
+			video:    Video{VideoId: "dQw4w9WgXcQ", Language: "en", AppliedLanguage: "en", AudioLanguage: "de", AppliedAudioLanguage: "en"},
+			expected: true,
+		},
+		{
+			name:     "Different casing is not drift",
+			video:    Video{VideoId: "dQw4w9WgXcQ", Language: "PT_br", AppliedLanguage: "pt-BR"},
+			expected: false,
+		},
+		{
+			name:     "Not uploaded yet",
+			video:    Video{Language: "fr", AppliedLanguage: "en"},
+			expected: false,
+		},
+		{
+			name:     "Invalid language fell back when applied",
+			video:    Video{VideoId: "dQw4w9WgXcQ", Language: "xx", AppliedLanguage: "en"},
+			expected: false,
+		},
+		{
+			name:     "Applied language never recorded",
+			video:    Video{VideoId: "dQw4w9WgXcQ", Language: "fr"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.video.LanguageDrift())
+		})
+	}
+}
+
+func TestValidateLanguages(t *testing.T) {
+	assert.NoError(t, Video{}.ValidateLanguages())
+	assert.NoError(t, Video{Language: "es", AudioLanguage: "pt-BR"}.ValidateLanguages())
+
+	err := Video{Language: "klingon", AudioLanguage: "xx"}.ValidateLanguages()
+	assert.ErrorContains(t, err, `Language: invalid language code "klingon"`)
+	assert.ErrorContains(t, err, `AudioLanguage: invalid language code "xx"`)
+}
+
+func TestValidateAll(t *testing.T) {
+	clean := Video{
+		Name: "clean", Path: "manuscript/testing/clean.yaml", Category: "testing",
+		Title: "Clean Video", Repo: "https://github.com/vfarcic/demo", Language: "en",
+		Timecodes: "00:00 Intro\n01:00 Setup\n05:00 Demo",
+	}
+	broken := Video{
+		Name: "broken", Path: "manuscript/testing/broken.yaml", Category: "testing",
+		Title: "List<T> Explained", Repo: "github.com/vfarcic/demo", Language: "klingon",
+		Gist: "missing.md", Timecodes: "01:00 Intro",
+	}
+	index := []VideoIndex{
+		{Name: "clean", Category: "testing"},
+		{Name: "broken", Category: "testing"},
+		{Name: "gone", Category: "testing"},
+	}
+	y := setupVideoFixtures(t, index, map[string]Video{"clean": clean, "broken": broken})
+
+	problems := y.ValidateAll()
+
+	brokenPath, gonePath := y.VideoPath(index[1]), y.VideoPath(index[2])
+	assert.ElementsMatch(t, []string{brokenPath, gonePath}, slices.Collect(maps.Keys(problems)), "clean videos are left out")
+
+	var messages []string
+	for _, err := range problems[brokenPath] {
+		messages = append(messages, err.Error())
+	}
+	require.Len(t, messages, 5)
+	assert.Contains(t, messages[0], "Repo: invalid URL")
+	assert.Contains(t, messages[1], "angle brackets")
+	assert.Contains(t, messages[2], "gist missing.md is not readable")
+	assert.Contains(t, messages[3], `Language: invalid language code "klingon"`)
+	assert.Contains(t, messages[4], "invalid timecodes")
+
+	require.Len(t, problems[gonePath], 1)
+	assert.ErrorIs(t, problems[gonePath][0], os.ErrNotExist)
+}
+
+func TestValidateAll_MissingIndex(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+
+	problems := y.ValidateAll()
+	require.Len(t, problems[y.IndexPath], 1)
+	assert.ErrorIs(t, problems[y.IndexPath][0], os.ErrNotExist)
+}
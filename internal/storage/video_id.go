@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// videoIDPattern matches YouTube video IDs: exactly 11 base64url characters.
+var videoIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+// videoLinkPattern captures the video ID of youtu.be, watch and shorts links.
+var videoLinkPattern = regexp.MustCompile(`(?:youtu\.be/|youtube\.com/watch\?v=|youtube\.com/shorts/)([^\s?&#,/]+)`)
+
+// IsValidVideoID reports whether id looks like a YouTube video ID.
+// Surrounding whitespace is not tolerated, so callers should trim user input first.
+func IsValidVideoID(id string) bool {
+	return videoIDPattern.MatchString(id)
+}
+
+// RelatedVideoIDs returns the IDs listed in RelatedVideos. Entries are separated by commas or
+// new lines and are either bare IDs or lines with YouTube links, such as "Title: https://youtu.be/ID",
+// in which case the IDs are taken from the links. Empty entries and the "N/A" and "-"
+// placeholders are skipped. Any invalid entry fails the whole list, with the error naming them.
+func (v Video) RelatedVideoIDs() ([]string, error) {
+	var ids, invalid []string
+	for _, line := range strings.Split(v.RelatedVideos, "\n") {
+		entries := strings.Split(line, ",")
+		if links := videoLinkPattern.FindAllStringSubmatch(line, -1); len(links) > 0 {
+			entries = entries[:0]
+			for _, link := range links {
+				entries = append(entries, link[1])
+			}
+		}
+		for _, entry := range entries {
+			entry = strings.TrimSpace(entry)
+			switch {
+			case entry == "" || entry == "N/A" || entry == "-":
+			case IsValidVideoID(entry):
+				ids = append(ids, entry)
+			default:
+				invalid = append(invalid, fmt.Sprintf("%q", entry))
+			}
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid related video IDs: %s", strings.Join(invalid, ", "))
+	}
+	return ids, nil
+}
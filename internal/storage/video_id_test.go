@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelatedVideoIDs(t *testing.T) {
+	tests := []struct {
+		name          string
+		relatedVideos string
+		expected      []string
+		errorContains []string
+	}{
+		{
+			name:          "Comma separated IDs with whitespace",
+			relatedVideos: " dQw4w9WgXcQ ,a-b_c-d_e-1,\tabcdefghijk ",
+			expected:      []string{"dQw4w9WgXcQ", "a-b_c-d_e-1", "abcdefghijk"},
+		},
+		{
+			name:          "Titled links on separate lines",
+			relatedVideos: "Crossplane Providers, Part 2: https://youtu.be/abcdefghijk\n\nN/A\nArgo CD: https://www.youtube.com/watch?v=bcdefghijkl&t=42",
+			expected:      []string{"abcdefghijk", "bcdefghijkl"},
+		},
+		{
+			name:          "Placeholders only",
+			relatedVideos: "N/A",
+		},
+		{
+			name:          "Empty",
+			relatedVideos: "",
+		},
+		{
+			name:          "Invalid entries are named",
+			relatedVideos: "dQw4w9WgXcQ, not-an-id ,abcdefghijk\nTitle: https://youtu.be/short",
+			errorContains: []string{`"not-an-id"`, `"short"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := Video{RelatedVideos: tt.relatedVideos}.RelatedVideoIDs()
+			if len(tt.errorContains) > 0 {
+				require.Error(t, err)
+				for _, s := range tt.errorContains {
+					assert.Contains(t, err.Error(), s)
+				}
+				assert.NotContains(t, err.Error(), "dQw4w9WgXcQ", "valid IDs must not be reported")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"devopstoolkit/youtube-automation/internal/filesystem"
+)
+
+// VideoPath resolves the YAML file path for an index entry. Paths are resolved
+// relative to the directory containing the index file, using the same naming
+// rules as the rest of the application.
+func (y *YAML) VideoPath(vi VideoIndex) string {
+	ops := filesystem.NewOperations()
+	relPath := ops.GetFilePath(vi.Category, ops.SanitizeName(vi.Name), "yaml")
+	return filepath.Join(filepath.Dir(y.IndexPath), relPath)
+}
+
+// GetAllVideos loads every video referenced by the index. Files that fail to load
+// don't abort the operation; their errors are combined into the returned error
+// alongside the videos that loaded successfully.
+func (y *YAML) GetAllVideos() ([]Video, error) {
+	index, err := y.GetIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	videos := make([]Video, 0, len(index))
+	var errs []error
+	for _, vi := range index {
+		video, err := y.loadIndexedVideo(vi)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		videos = append(videos, video)
+	}
+	return videos, errors.Join(errs...)
+}
+
+// ErrStopIteration is returned by the function passed to EachVideo to stop the iteration
+// early without it being reported as an error.
+var ErrStopIteration = errors.New("stop iteration")
+
+// EachVideo calls fn with every video referenced by the index, in index order, loading one file
+// at a time so memory stays bounded however large the index is. Like GetAllVideos, files that
+// fail to load are skipped and their errors combined into the returned error. An error from fn
+// stops the iteration and is returned along with those load errors, except for
+// ErrStopIteration, which stops it quietly.
+func (y *YAML) EachVideo(fn func(Video) error) error {
+	index, err := y.GetIndex()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, vi := range index {
+		video, err := y.loadIndexedVideo(vi)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := fn(video); err != nil {
+			if !errors.Is(err, ErrStopIteration) {
+				errs = append(errs, err)
+			}
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetAllVideosConcurrent behaves like GetAllVideos but loads the files with up to
+// maxWorkers goroutines. The returned videos keep the index order regardless of the
+// order in which loads complete. maxWorkers <= 0 defaults to runtime.NumCPU().
+func (y *YAML) GetAllVideosConcurrent(maxWorkers int) ([]Video, error) {
+	index, err := y.GetIndex()
+	if err != nil {
+		return nil, err
+	}
+	if maxWorkers <= 0 {
+		maxWorkers = runtime.NumCPU()
+	}
+
+	type result struct {
+		video Video
+		err   error
+	}
+	results := make([]result, len(index))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < maxWorkers && w < len(index); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				video, err := y.loadIndexedVideo(index[i])
+				results[i] = result{video: video, err: err}
+			}
+		}()
+	}
+	for i := range index {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	videos := make([]Video, 0, len(index))
+	var errs []error
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		videos = append(videos, r.video)
+	}
+	return videos, errors.Join(errs...)
+}
+
+// loadIndexedVideo reads the video for an index entry, filling in the path and
+// category from the index when the file doesn't carry them.
+func (y *YAML) loadIndexedVideo(vi VideoIndex) (Video, error) {
+	path := y.VideoPath(vi)
+	video, err := y.GetVideo(path)
+	if err != nil {
+		return video, fmt.Errorf("failed to load video %s (%s): %w", vi.Name, vi.Category, err)
+	}
+	if video.Path == "" {
+		video.Path = path
+	}
+	if video.Category == "" {
+		video.Category = vi.Category
+	}
+	return video, nil
+}
@@ -0,0 +1,269 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupVideoFixtures writes an index plus a YAML file for every video in videos
+// and returns a YAML instance pointing at the index.
+func setupVideoFixtures(t *testing.T, index []VideoIndex, videos map[string]Video) *YAML {
+	t.Helper()
+	dir := t.TempDir()
+	y := NewYAML(filepath.Join(dir, "index.yaml"))
+	require.NoError(t, y.WriteIndex(index))
+
+	for _, vi := range index {
+		video, ok := videos[vi.Name]
+		if !ok {
+			continue
+		}
+		path := y.VideoPath(vi)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, y.WriteVideo(video, path))
+	}
+	return y
+}
+
+func TestVideoPath(t *testing.T) {
+	y := NewYAML(filepath.Join("data", "index.yaml"))
+	path := y.VideoPath(VideoIndex{Name: "My Video", Category: "Dev Tools"})
+	assert.Equal(t, filepath.Join("data", "manuscript", "dev-tools", "my-video.yaml"), path)
+}
+
+func TestGetAllVideos(t *testing.T) {
+	index := []VideoIndex{
+		{Name: "first", Category: "testing"},
+		{Name: "missing", Category: "testing"},
+		{Name: "third", Category: "other"},
+	}
+	y := setupVideoFixtures(t, index, map[string]Video{
+		"first": {Name: "first", Title: "First Video"},
+		"third": {Name: "third", Title: "Third Video"},
+	})
+
+	videos, err := y.GetAllVideos()
+
+	require.Error(t, err, "missing files should be reported")
+	assert.Contains(t, err.Error(), "missing")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	require.Len(t, videos, 2, "valid files should still load")
+	assert.Equal(t, "First Video", videos[0].Title)
+	assert.Equal(t, "testing", videos[0].Category)
+	assert.Equal(t, y.VideoPath(index[0]), videos[0].Path)
+	assert.Equal(t, "Third Video", videos[1].Title)
+}
+
+func TestGetAllVideos_AllValid(t *testing.T) {
+	index := []VideoIndex{{Name: "only", Category: "testing"}}
+	y := setupVideoFixtures(t, index, map[string]Video{"only": {Name: "only"}})
+
+	videos, err := y.GetAllVideos()
+	require.NoError(t, err)
+	assert.Len(t, videos, 1)
+}
+
+func TestGetAllVideos_MissingIndex(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+
+	videos, err := y.GetAllVideos()
+	assert.Error(t, err)
+	assert.Nil(t, videos)
+}
+
+func TestEachVideo(t *testing.T) {
+	index := []VideoIndex{
+		{Name: "first", Category: "testing"},
+		{Name: "missing", Category: "testing"},
+		{Name: "third", Category: "other"},
+	}
+	y := setupVideoFixtures(t, index, map[string]Video{
+		"first": {Name: "first", Title: "First Video"},
+		"third": {Name: "third", Title: "Third Video"},
+	})
+
+	var titles []string
+	err := y.EachVideo(func(video Video) error {
+		titles = append(titles, video.Title)
+		return nil
+	})
+
+	require.Error(t, err, "missing files should be reported")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.Equal(t, []string{"First Video", "Third Video"}, titles, "valid files are still visited, in index order")
+}
+
+func TestEachVideo_StopsEarly(t *testing.T) {
+	index := []VideoIndex{
+		{Name: "first", Category: "testing"},
+		{Name: "second", Category: "testing"},
+		{Name: "third", Category: "testing"},
+	}
+	y := setupVideoFixtures(t, index, map[string]Video{
+		"first":  {Name: "first"},
+		"second": {Name: "second"},
+		"third":  {Name: "third"},
+	})
+
+	var names []string
+	err := y.EachVideo(func(video Video) error {
+		names = append(names, video.Name)
+		if video.Name == "second" {
+			return ErrStopIteration
+		}
+		return nil
+	})
+
+	require.NoError(t, err, "stopping early is not an error")
+	assert.Equal(t, []string{"first", "second"}, names)
+}
+
+func TestEachVideo_PropagatesCallbackError(t *testing.T) {
+	index := []VideoIndex{
+		{Name: "first", Category: "testing"},
+		{Name: "second", Category: "testing"},
+	}
+	y := setupVideoFixtures(t, index, map[string]Video{
+		"first":  {Name: "first"},
+		"second": {Name: "second"},
+	})
+
+	failure := fmt.Errorf("processing failed")
+	calls := 0
+	err := y.EachVideo(func(video Video) error {
+		calls++
+		return failure
+	})
+
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, 1, calls, "an error stops the iteration")
+}
+
+func TestEachVideo_MissingIndex(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+
+	err := y.EachVideo(func(Video) error {
+		t.Fatal("no video should be visited")
+		return nil
+	})
+	assert.Error(t, err)
+}
+
+func TestGetAllVideosConcurrent_PreservesOrder(t *testing.T) {
+	const count = 60
+	index := make([]VideoIndex, 0, count)
+	videos := make(map[string]Video, count)
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("video-%02d", i)
+		index = append(index, VideoIndex{Name: name, Category: "testing"})
+		if i%10 != 5 {
+			videos[name] = Video{Name: name, Title: fmt.Sprintf("Title %02d", i)}
+		}
+	}
+	y := setupVideoFixtures(t, index, videos)
+
+	for _, workers := range []int{0, 1, 4, 16, count * 2} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			loaded, err := y.GetAllVideosConcurrent(workers)
+
+			require.Error(t, err, "missing files should be reported")
+			assert.ErrorIs(t, err, os.ErrNotExist)
+
+			sequential, seqErr := y.GetAllVideos()
+			require.Error(t, seqErr)
+			assert.Equal(t, sequential, loaded, "concurrent loading should match sequential index order")
+			assert.Equal(t, seqErr.Error(), err.Error(), "errors should be reported in index order")
+			assert.Len(t, loaded, count-count/10)
+		})
+	}
+}
+
+func TestGetAllVideosConcurrent_EmptyIndex(t *testing.T) {
+	y := setupVideoFixtures(t, []VideoIndex{}, nil)
+
+	videos, err := y.GetAllVideosConcurrent(4)
+	require.NoError(t, err)
+	assert.Empty(t, videos)
+}
+
+func BenchmarkGetAllVideos(b *testing.B) {
+	dir := b.TempDir()
+	y := NewYAML(filepath.Join(dir, "index.yaml"))
+	index := make([]VideoIndex, 0, 200)
+	for i := 0; i < 200; i++ {
+		vi := VideoIndex{Name: fmt.Sprintf("video-%03d", i), Category: "bench"}
+		index = append(index, vi)
+		path := y.VideoPath(vi)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			b.Fatal(err)
+		}
+		if err := y.WriteVideo(Video{Name: vi.Name, Description: "Benchmark video"}, path); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if err := y.WriteIndex(index); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("Sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := y.GetAllVideos(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Concurrent", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := y.GetAllVideosConcurrent(0); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestGetIndexSortedByPublishDate(t *testing.T) {
+	index := []VideoIndex{
+		{Name: "undated", Category: "testing"},
+		{Name: "march", Category: "testing"},
+		{Name: "missing", Category: "testing"},
+		{Name: "january", Category: "other"},
+		{Name: "invalid", Category: "testing"},
+		{Name: "february", Category: "testing"},
+	}
+	y := setupVideoFixtures(t, index, map[string]Video{
+		"undated":  {Name: "undated"},
+		"march":    {Name: "march", Date: "2025-03-01T10:00"},
+		"january":  {Name: "january", Date: "2025-01-15T16:00"},
+		"invalid":  {Name: "invalid", Date: "next week"},
+		"february": {Name: "february", Date: "2025-02-01T10:00"},
+	})
+	names := func(entries []VideoIndex) []string {
+		var result []string
+		for _, vi := range entries {
+			result = append(result, vi.Name)
+		}
+		return result
+	}
+
+	ascending, err := y.GetIndexSortedByPublishDate(true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"january", "february", "march", "undated", "missing", "invalid"}, names(ascending))
+
+	descending, err := y.GetIndexSortedByPublishDate(false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"march", "february", "january", "undated", "missing", "invalid"}, names(descending),
+		"undated videos sort last in both directions, keeping their index order")
+}
+
+func TestGetIndexSortedByPublishDate_MissingIndex(t *testing.T) {
+	y := NewYAML(filepath.Join(t.TempDir(), "index.yaml"))
+
+	_, err := y.GetIndexSortedByPublishDate(true)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -1,9 +1,18 @@
 package storage
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"slices"
+	"syscall"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 )
 
@@ -12,6 +21,16 @@ import (
 // Ensure all fields that need to be accessed from other packages are exported (start with a capital letter).
 type YAML struct {
 	IndexPath string
+	// BackupDir, when set, makes WriteVideo copy an existing video file to a
+	// timestamped .bak file in this directory before overwriting it.
+	BackupDir string
+	// NormalizeTagsOnWrite makes WriteVideo clean up the video's tags with
+	// NormalizeTags before saving it.
+	NormalizeTagsOnWrite bool
+	// RecoverIndex makes WriteIndex keep the previous index as a .bak file next to it, and
+	// GetIndex fall back to that backup when the index can't be parsed, such as after a
+	// truncated write. The unparsable index is kept as a .corrupt file for inspection.
+	RecoverIndex bool
 }
 
 // VideoIndex holds basic information about a video, used in the index file.
@@ -25,8 +44,15 @@ type YAML struct {
 type VideoIndex struct {
 	Name     string
 	Category string
+	// Phase is the video's CurrentPhase, or IndexPhaseComplete once every phase is done, as of
+	// the last WriteVideo through a YAML with this index. It is empty in indexes written before
+	// it was tracked.
+	Phase string `yaml:"phase,omitempty"`
 }
 
+// IndexPhaseComplete is the VideoIndex.Phase of a video with every phase done.
+const IndexPhaseComplete = "Complete"
+
 // Video represents all data associated with a video project.
 // All fields are already exported.
 type Video struct {
@@ -81,6 +107,11 @@ type Video struct {
 	AudioLanguage        string      `yaml:"audioLanguage,omitempty" json:"audioLanguage,omitempty" completion:"filled_only"`
 	Gist                 string      `yaml:"gist,omitempty" json:"gist,omitempty" completion:"filled_only"`
 	Code                 bool        `yaml:"code,omitempty" json:"code,omitempty" completion:"true_only"`
+	Unlisted             bool        `yaml:"unlisted,omitempty" json:"unlisted,omitempty" completion:"empty_or_filled"`
+	MadeForKids          *bool       `yaml:"madeForKids,omitempty" json:"madeForKids,omitempty" completion:"empty_or_filled"`
+	CreatedAt            time.Time   `yaml:"createdAt,omitempty" json:"createdAt,omitzero"`
+	UpdatedAt            time.Time   `yaml:"updatedAt,omitempty" json:"updatedAt,omitzero"`
+	SchemaVersion        int         `yaml:"schemaVersion,omitempty" json:"schemaVersion,omitempty"`
 }
 
 // Sponsorship holds details about video sponsorship.
@@ -104,31 +135,120 @@ func NewYAML(indexPath string) *YAML {
 	}
 }
 
+// GetVideo reads the video at path as it is saved, without any defaults; see
+// GetVideoWithDefaults.
 func (y *YAML) GetVideo(path string) (Video, error) {
-	var video Video
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return video, fmt.Errorf("failed to read video file %s: %w", path, err)
-	}
-	err = yaml.Unmarshal(data, &video)
+	dir := filepath.Dir(path)
+	return getVideoFS(os.DirFS(dir), filepath.Base(path), dir)
+}
+
+// GetVideoFS works like GetVideo but reads the video from fsys, such as metadata bundled with
+// embed.FS. path is slash-separated, as fs.FS requires.
+func (y *YAML) GetVideoFS(fsys fs.FS, path string) (Video, error) {
+	return getVideoFS(fsys, path, "")
+}
+
+// getVideoFS reads the video name from fsys. Errors name the file as joined to dir, the
+// directory fsys was opened from, if any.
+func getVideoFS(fsys fs.FS, name, dir string) (Video, error) {
+	data, err := fs.ReadFile(fsys, name)
 	if err != nil {
-		return video, fmt.Errorf("failed to unmarshal video data from %s: %w", path, err)
+		return Video{}, fmt.Errorf("failed to read video file %s: %w", filepath.Join(dir, name), err)
 	}
-	return video, nil
+	return decodeVideo(data, filepath.Join(dir, name), nil)
 }
 
+// WriteVideo saves the video to path, stamping its UpdatedAt with the current time unless only
+// the timestamps differ from the video already saved there. A video without CreatedAt keeps the
+// one already saved at path, or gets the current time when the file is new.
+// When IndexPath is set, the Phase of the video's index entry is kept up to date.
 func (y *YAML) WriteVideo(video Video, path string) error {
+	if video.SchemaVersion == 0 {
+		video.SchemaVersion = CurrentSchemaVersion
+	}
+	if y.NormalizeTagsOnWrite {
+		video.Tags = NormalizeTags(video.Tags)
+	}
+	saved := savedVideo(path)
+	if video.CreatedAt.IsZero() && saved != nil {
+		video.CreatedAt = saved.CreatedAt
+	}
+	stampTimes(&video, saved)
+	// yaml.v3 writes struct fields in declaration order and sorts map keys, so the same video
+	// always produces the same bytes and unchanged metadata doesn't show up in git diffs
 	data, err := yaml.Marshal(&video)
 	if err != nil {
 		return fmt.Errorf("failed to marshal video data for %s: %w", path, err)
 	}
-	err = os.WriteFile(path, data, 0644)
+	if y.BackupDir != "" {
+		if err := y.backupFile(path); err != nil {
+			return fmt.Errorf("failed to back up video file %s: %w", path, err)
+		}
+	}
+	err = writeFileAtomic(path, data, 0644)
 	if err != nil {
-		return fmt.Errorf("failed to write video data to file %s: %w", path, err)
+		return fmt.Errorf("failed to write video data to file %s: %w", path, notWritable(filepath.Dir(path), err))
+	}
+	if err := y.updateIndexPhase(video, path); err != nil {
+		return fmt.Errorf("video saved to %s but its index entry could not be updated: %w", path, err)
 	}
 	return nil
 }
 
+// now returns the current time, replaceable for testing
+var now = time.Now
+
+// stampTimes sets the video's UpdatedAt, and its CreatedAt when missing, to the current time,
+// in UTC and to the second so they serialize as plain RFC 3339 timestamps. When saved, the video
+// as last written, has the same content apart from UpdatedAt, its UpdatedAt is kept instead, so
+// saving an unchanged video leaves its file as it is.
+func stampTimes(video *Video, saved *Video) {
+	timestamp := now().UTC().Truncate(time.Second)
+	if video.CreatedAt.IsZero() {
+		video.CreatedAt = timestamp
+	}
+	video.UpdatedAt = timestamp
+	if saved == nil || saved.UpdatedAt.IsZero() {
+		return
+	}
+	unchanged := *video
+	unchanged.UpdatedAt = saved.UpdatedAt
+	current, err := yaml.Marshal(&unchanged)
+	if err != nil {
+		return
+	}
+	if previous, err := yaml.Marshal(saved); err == nil && bytes.Equal(current, previous) {
+		video.UpdatedAt = saved.UpdatedAt
+	}
+}
+
+// savedVideo returns the video file at path as written, without migrating it, or nil when the
+// file doesn't exist or can't be read.
+func savedVideo(path string) *Video {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var saved Video
+	if err := yaml.Unmarshal(data, &saved); err != nil {
+		return nil
+	}
+	return &saved
+}
+
+// ErrNotWritable reports that video metadata could not be saved because its directory, or the
+// filesystem it is on, doesn't allow writing.
+var ErrNotWritable = errors.New("metadata directory is not writable")
+
+// notWritable wraps permission and read-only filesystem errors with ErrNotWritable, keeping
+// the original error available to errors.Is. Other errors are returned unchanged.
+func notWritable(dir string, err error) error {
+	if errors.Is(err, os.ErrPermission) || errors.Is(err, syscall.EROFS) {
+		return fmt.Errorf("%w: %s: %w", ErrNotWritable, dir, err)
+	}
+	return err
+}
+
 func (y *YAML) GetIndex() ([]VideoIndex, error) {
 	var index []VideoIndex
 	data, err := os.ReadFile(y.IndexPath)
@@ -137,23 +257,193 @@ func (y *YAML) GetIndex() ([]VideoIndex, error) {
 	}
 	err = yaml.Unmarshal(data, &index)
 	if err != nil {
+		if y.RecoverIndex {
+			if recovered, recoverErr := y.recoverIndex(data, err); recoverErr == nil {
+				return recovered, nil
+			}
+		}
 		return index, fmt.Errorf("failed to unmarshal video index from %s: %w", y.IndexPath, err)
 	}
 	return index, nil
 }
 
+// indexBackupPath returns the backup kept by WriteIndex when RecoverIndex is set.
+func (y *YAML) indexBackupPath() string {
+	return y.IndexPath + ".bak"
+}
+
+// recoverIndex replaces the unparsable index, whose content is corrupt, with its backup and
+// returns the backed up entries. The corrupt content is saved to a .corrupt file first.
+func (y *YAML) recoverIndex(corrupt []byte, parseErr error) ([]VideoIndex, error) {
+	data, err := os.ReadFile(y.indexBackupPath())
+	if err != nil {
+		return nil, err
+	}
+	var index []VideoIndex
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return nil, err
+	}
+
+	corruptPath := y.IndexPath + ".corrupt"
+	if err := writeFileAtomic(corruptPath, corrupt, 0644); err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(y.IndexPath, data, 0644); err != nil {
+		return nil, err
+	}
+	baseEntry().WithError(parseErr).WithFields(logrus.Fields{
+		"index":   y.IndexPath,
+		"backup":  y.indexBackupPath(),
+		"corrupt": corruptPath,
+	}).Warn("Video index is corrupt, restored it from its backup")
+	return index, nil
+}
+
+// backupIndex copies the current index to indexBackupPath. Nothing is copied when there is no
+// index yet or it can't be parsed, so a corrupt index never replaces a good backup.
+func (y *YAML) backupIndex() error {
+	data, err := os.ReadFile(y.IndexPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var index []VideoIndex
+	if yaml.Unmarshal(data, &index) != nil {
+		return nil
+	}
+	return writeFileAtomic(y.indexBackupPath(), data, 0644)
+}
+
+// WriteIndex persists the video index, returning an error if it could not be marshalled or written.
+// Entries without a Phase, such as new ones, get it from their video when it can be read; the
+// others keep theirs, since WriteVideo updates it.
 func (y *YAML) WriteIndex(vi []VideoIndex) error {
+	vi = slices.Clone(vi)
+	for i := range vi {
+		if vi[i].Phase != "" {
+			continue
+		}
+		if video, err := y.GetVideo(y.VideoPath(vi[i])); err == nil {
+			vi[i].Phase = indexPhase(video)
+		}
+	}
 	data, err := yaml.Marshal(&vi)
 	if err != nil {
-		return fmt.Errorf("failed to marshal video index: %w", err)
+		return fmt.Errorf("failed to marshal video index for %s: %w", y.IndexPath, err)
 	}
-	err = os.WriteFile(y.IndexPath, data, 0644)
+	if y.RecoverIndex {
+		if err := y.backupIndex(); err != nil {
+			return fmt.Errorf("failed to back up video index %s: %w", y.IndexPath, err)
+		}
+	}
+	err = writeFileAtomic(y.IndexPath, data, 0644)
 	if err != nil {
-		return fmt.Errorf("failed to write video index to file %s: %w", y.IndexPath, err)
+		return fmt.Errorf("failed to write video index to %s: %w", y.IndexPath, err)
 	}
 	return nil
 }
 
+// updateIndexPhase sets the Phase of the index entry of the video saved at path, rewriting the
+// index only when the phase changed. It does nothing without an index or an entry for path.
+func (y *YAML) updateIndexPhase(video Video, path string) error {
+	if y.IndexPath == "" {
+		return nil
+	}
+	index, err := y.GetIndex()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	target := absPath(path)
+	for i := range index {
+		if absPath(y.VideoPath(index[i])) != target {
+			continue
+		}
+		if phase := indexPhase(video); index[i].Phase != phase {
+			index[i].Phase = phase
+			return y.WriteIndex(index)
+		}
+		return nil
+	}
+	return nil
+}
+
+// absPath returns the absolute form of path, or the cleaned path when it can't be resolved.
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+// indexPhase returns the VideoIndex.Phase of video.
+func indexPhase(video Video) string {
+	if phase := video.CurrentPhase(); phase != "" {
+		return phase
+	}
+	return IndexPhaseComplete
+}
+
+// backupFile copies the file at path into BackupDir with a timestamped .bak name.
+// It does nothing when the file doesn't exist yet.
+func (y *YAML) backupFile(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(y.BackupDir, 0755); err != nil {
+		return err
+	}
+	timestamp := time.Now().UTC().Format("20060102T150405.000000000Z")
+	backupPath := filepath.Join(y.BackupDir, fmt.Sprintf("%s.%s.bak", filepath.Base(path), timestamp))
+	return writeFileAtomic(backupPath, data, 0644)
+}
+
+// writeData writes data to the temporary file during atomic writes; replaceable for testing
+var writeData = func(w io.Writer, data []byte) (int, error) {
+	return w.Write(data)
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory and renames it
+// over path, so readers never observe a partially written file. If anything fails the
+// temporary file is removed and the existing file is left untouched.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer func() {
+		if err != nil {
+			os.Remove(tmpPath)
+		}
+	}()
+
+	if _, err = writeData(tmp, data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+	err = os.Rename(tmpPath, path)
+	return err
+}
+
 // GetLanguage returns the video language or the default if not set
 func (v *Video) GetLanguage(defaultLang string) string {
 	if v.Language == "" {
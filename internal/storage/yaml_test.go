@@ -2,11 +2,19 @@ package storage
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"reflect"
+	"runtime"
 	"strings"
+	"syscall"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -216,7 +224,9 @@ func TestWriteIndex(t *testing.T) {
 	y := YAML{
 		IndexPath: testPath,
 	}
-	y.WriteIndex(testIndex)
+	if err := y.WriteIndex(testIndex); err != nil {
+		t.Fatalf("WriteIndex returned an error: %v", err)
+	}
 
 	// Verify the file was created
 	if _, err := os.Stat(testPath); os.IsNotExist(err) {
@@ -287,6 +297,27 @@ func TestGetVideo_FileNotFound(t *testing.T) {
 	}
 }
 
+func TestGetVideoFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"videos/video.yaml":          {Data: []byte("name: Video\ncategory: ai\n")},
+		"videos/" + DefaultsFileName: {Data: []byte("tags: DevOps\ncategory: development\n")},
+	}
+
+	video, err := (&YAML{}).GetVideoFS(fsys, "videos/video.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "Video", video.Name)
+	assert.Equal(t, "ai", video.Category)
+	assert.Empty(t, video.Tags, "defaults are only applied by GetVideoWithDefaults")
+	assert.Equal(t, CurrentSchemaVersion, video.SchemaVersion)
+}
+
+func TestGetVideoFS_FileNotFound(t *testing.T) {
+	_, err := (&YAML{}).GetVideoFS(fstest.MapFS{}, "videos/missing.yaml")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	assert.ErrorContains(t, err, "failed to read video file videos/missing.yaml")
+}
+
 func TestGetVideo_InvalidYAML(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "invalid-yaml-test")
 	if err != nil {
@@ -356,6 +387,74 @@ func TestGetIndex_InvalidYAML(t *testing.T) {
 	}
 }
 
+func TestGetIndex_RecoversFromBackup(t *testing.T) {
+	dir := t.TempDir()
+	y := &YAML{IndexPath: filepath.Join(dir, "index.yaml"), RecoverIndex: true}
+	original := []VideoIndex{{Name: "Test Video 1", Category: "testing"}}
+	require.NoError(t, y.WriteIndex(original))
+	require.NoError(t, y.WriteIndex(append(original, VideoIndex{Name: "Test Video 2", Category: "testing"})))
+	assert.FileExists(t, y.IndexPath+".bak", "WriteIndex keeps the previous index")
+
+	truncated := []byte(`[{"name": "Test Video 1", "category": "testing"}, {"name": "Test Vi`)
+	require.NoError(t, os.WriteFile(y.IndexPath, truncated, 0644))
+
+	logs := captureLogs(t)
+	index, err := y.GetIndex()
+	require.NoError(t, err)
+	assert.Equal(t, original, index)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry), "the recovery is logged as one structured warning")
+	assert.Equal(t, "warning", entry["level"])
+	assert.Equal(t, "storage", entry["component"])
+	assert.Equal(t, y.IndexPath+".bak", entry["backup"])
+	assert.Equal(t, y.IndexPath+".corrupt", entry["corrupt"])
+	assert.NotEmpty(t, entry["error"])
+
+	corrupt, err := os.ReadFile(y.IndexPath + ".corrupt")
+	require.NoError(t, err)
+	assert.Equal(t, truncated, corrupt, "the corrupt index is kept for inspection")
+
+	index, err = (&YAML{IndexPath: y.IndexPath}).GetIndex()
+	require.NoError(t, err)
+	assert.Equal(t, original, index, "the index file itself is restored")
+}
+
+func TestGetIndex_RecoveryWithoutBackup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("[{invalid"), 0644))
+
+	logs := captureLogs(t)
+	_, err := (&YAML{IndexPath: path, RecoverIndex: true}).GetIndex()
+	assert.ErrorContains(t, err, "failed to unmarshal video index")
+	assert.NoFileExists(t, path+".corrupt")
+	assert.Empty(t, logs.String(), "nothing is recovered, so nothing is logged")
+}
+
+func TestGetIndex_RecoveryDisabled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("[{invalid"), 0644))
+	require.NoError(t, os.WriteFile(path+".bak", []byte("- name: Test Video 1\n  category: testing\n"), 0644))
+
+	_, err := (&YAML{IndexPath: path}).GetIndex()
+	assert.ErrorContains(t, err, "failed to unmarshal video index")
+	assert.NoFileExists(t, path+".corrupt")
+}
+
+func TestWriteIndex_KeepsGoodBackupOverCorruptIndex(t *testing.T) {
+	dir := t.TempDir()
+	y := &YAML{IndexPath: filepath.Join(dir, "index.yaml"), RecoverIndex: true}
+	backup := []byte("- name: Test Video 1\n  category: testing\n")
+	require.NoError(t, os.WriteFile(y.IndexPath+".bak", backup, 0644))
+	require.NoError(t, os.WriteFile(y.IndexPath, []byte("[{invalid"), 0644))
+
+	require.NoError(t, y.WriteIndex([]VideoIndex{{Name: "Test Video 2", Category: "testing"}}))
+
+	data, err := os.ReadFile(y.IndexPath + ".bak")
+	require.NoError(t, err)
+	assert.Equal(t, backup, data)
+}
+
 func TestVideo_JSONConsistency(t *testing.T) {
 	t.Run("Video struct should serialize to camelCase JSON", func(t *testing.T) {
 		video := Video{
@@ -661,3 +760,300 @@ func TestVideo_BackwardCompatibility(t *testing.T) {
 		assert.Equal(t, "fr", audioLanguage)
 	})
 }
+
+// TestWriteVideo_AtomicOnPartialWrite verifies a failed write leaves the original file untouched
+func TestWriteVideo_AtomicOnPartialWrite(t *testing.T) {
+	tempDir := t.TempDir()
+	testPath := filepath.Join(tempDir, "atomic-video.yaml")
+
+	y := YAML{}
+	original := Video{Name: "Original", Category: "testing", Description: "Hand-edited description"}
+	require.NoError(t, y.WriteVideo(original, testPath))
+	originalData, err := os.ReadFile(testPath)
+	require.NoError(t, err)
+
+	// Simulate the process dying halfway through the write
+	originalWriteData := writeData
+	writeData = func(w io.Writer, data []byte) (int, error) {
+		n, _ := w.Write(data[:len(data)/2])
+		return n, errors.New("simulated crash mid-write")
+	}
+	defer func() { writeData = originalWriteData }()
+
+	err = y.WriteVideo(Video{Name: "Replacement", Category: "testing"}, testPath)
+	require.Error(t, err)
+
+	currentData, err := os.ReadFile(testPath)
+	require.NoError(t, err)
+	assert.Equal(t, originalData, currentData, "original file should be untouched after a failed write")
+
+	entries, err := os.ReadDir(tempDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files should be cleaned up after a failed write")
+}
+
+// TestWriteFileAtomic_RenameFailure verifies the temporary file is removed when it can't replace the target
+func TestWriteFileAtomic_RenameFailure(t *testing.T) {
+	tempDir := t.TempDir()
+	target := filepath.Join(tempDir, "video.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Join(target, "occupied"), 0755))
+
+	err := writeFileAtomic(target, []byte("name: Video\n"), 0644)
+	require.Error(t, err)
+
+	entries, err := os.ReadDir(tempDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "temporary files should be cleaned up after a failed rename")
+	assert.Equal(t, "video.yaml", entries[0].Name())
+}
+
+// TestWriteIndex_AtomicOnPartialWrite verifies a failed index write leaves the original index untouched
+func TestWriteIndex_AtomicOnPartialWrite(t *testing.T) {
+	tempDir := t.TempDir()
+	y := YAML{IndexPath: filepath.Join(tempDir, "index.yaml")}
+
+	require.NoError(t, y.WriteIndex([]VideoIndex{{Name: "Video 1", Category: "testing"}}))
+
+	originalWriteData := writeData
+	writeData = func(w io.Writer, data []byte) (int, error) {
+		return 0, errors.New("disk full")
+	}
+	defer func() { writeData = originalWriteData }()
+
+	err := y.WriteIndex([]VideoIndex{{Name: "Video 2", Category: "testing"}})
+	require.Error(t, err)
+
+	index, err := y.GetIndex()
+	require.NoError(t, err)
+	assert.Equal(t, []VideoIndex{{Name: "Video 1", Category: "testing"}}, index)
+}
+
+// TestWriteVideo_Permissions verifies atomic writes keep the 0644 file mode
+func TestWriteVideo_Permissions(t *testing.T) {
+	testPath := filepath.Join(t.TempDir(), "perm-video.yaml")
+
+	y := YAML{}
+	require.NoError(t, y.WriteVideo(Video{Name: "Perm"}, testPath))
+
+	info, err := os.Stat(testPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+}
+
+// TestWriteIndex_Error verifies write failures are propagated with context
+func TestWriteIndex_Error(t *testing.T) {
+	indexPath := filepath.Join(t.TempDir(), "missing-dir", "index.yaml")
+	y := YAML{IndexPath: indexPath}
+
+	err := y.WriteIndex([]VideoIndex{{Name: "Video", Category: "testing"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("failed to write video index to %s", indexPath))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// TestWriteVideo_Backup verifies the previous file is backed up before being overwritten
+func TestWriteVideo_Backup(t *testing.T) {
+	tempDir := t.TempDir()
+	backupDir := filepath.Join(tempDir, "backups")
+	testPath := filepath.Join(tempDir, "backup-video.yaml")
+
+	y := YAML{BackupDir: backupDir}
+
+	// First write has nothing to back up
+	original := Video{Name: "Original", Description: "Hand-edited description"}
+	require.NoError(t, y.WriteVideo(original, testPath))
+	_, err := os.Stat(backupDir)
+	assert.True(t, os.IsNotExist(err), "no backup should be made when no prior file exists")
+
+	originalData, err := os.ReadFile(testPath)
+	require.NoError(t, err)
+
+	updated := Video{Name: "Original", Description: "Overwritten by a script"}
+	require.NoError(t, y.WriteVideo(updated, testPath))
+
+	backups, err := filepath.Glob(filepath.Join(backupDir, "backup-video.yaml.*.bak"))
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+
+	backupData, err := os.ReadFile(backups[0])
+	require.NoError(t, err)
+	assert.Equal(t, originalData, backupData, "backup should match the pre-write state")
+
+	current, err := y.GetVideo(testPath)
+	require.NoError(t, err)
+	assert.Equal(t, "Overwritten by a script", current.Description)
+}
+
+// TestWriteVideo_NoBackupByDefault verifies backups are opt-in
+func TestWriteVideo_NoBackupByDefault(t *testing.T) {
+	tempDir := t.TempDir()
+	testPath := filepath.Join(tempDir, "video.yaml")
+
+	y := YAML{}
+	require.NoError(t, y.WriteVideo(Video{Name: "First"}, testPath))
+	require.NoError(t, y.WriteVideo(Video{Name: "Second"}, testPath))
+
+	entries, err := os.ReadDir(tempDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+// TestWriteVideo_NormalizeTags verifies tags are only normalized when enabled
+func TestWriteVideo_NormalizeTags(t *testing.T) {
+	testPath := filepath.Join(t.TempDir(), "video.yaml")
+	video := Video{Name: "Video", Tags: "Kubernetes, kubernetes ,GitOps"}
+
+	y := YAML{}
+	require.NoError(t, y.WriteVideo(video, testPath))
+	written, err := y.GetVideo(testPath)
+	require.NoError(t, err)
+	assert.Equal(t, video.Tags, written.Tags)
+
+	y.NormalizeTagsOnWrite = true
+	require.NoError(t, y.WriteVideo(video, testPath))
+	written, err = y.GetVideo(testPath)
+	require.NoError(t, err)
+	assert.Equal(t, "Kubernetes, GitOps", written.Tags)
+}
+
+// populate sets every field reachable from v to a non-zero value derived from name, giving
+// maps several entries, so tests cover fields added later without being updated.
+func populate(v reflect.Value, name string) {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(name)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(len(name)))
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				populate(v.Field(i), name+"."+v.Type().Field(i).Name)
+			}
+		}
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		populate(v.Elem(), name)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			populate(v.Index(i), fmt.Sprintf("%s[%d]", name, i))
+		}
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		for _, suffix := range []string{"gamma", "alpha", "beta"} {
+			key, value := reflect.New(v.Type().Key()).Elem(), reflect.New(v.Type().Elem()).Elem()
+			populate(key, name+"."+suffix)
+			populate(value, name+"."+suffix)
+			v.SetMapIndex(key, value)
+		}
+	}
+}
+
+func TestWriteVideo_Deterministic(t *testing.T) {
+	written := time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+	setNow(t, written)
+	path := filepath.Join(t.TempDir(), "video.yaml")
+	var video Video
+	populate(reflect.ValueOf(&video).Elem(), "Video")
+	video.CreatedAt, video.UpdatedAt = time.Time{}, time.Time{}
+	y := YAML{}
+
+	require.NoError(t, y.WriteVideo(video, path))
+	firstData, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// Later saves of the same video, as given or as read back, must leave the file untouched
+	setNow(t, written.Add(24*time.Hour))
+	read, err := y.GetVideo(path)
+	require.NoError(t, err)
+	for _, same := range []Video{video, read} {
+		require.NoError(t, y.WriteVideo(same, path))
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, string(firstData), string(data), "writing the same video must produce identical bytes")
+	}
+
+	read.Title += " (edited)"
+	require.NoError(t, y.WriteVideo(read, path))
+	edited, err := y.GetVideo(path)
+	require.NoError(t, err)
+	assert.True(t, edited.UpdatedAt.Equal(written.Add(24*time.Hour)), "a changed video gets a new UpdatedAt")
+	assert.True(t, edited.CreatedAt.Equal(written))
+}
+
+// TestYAMLMarshal_SortsMapKeys pins down the yaml.v3 behavior deterministic writes rely on
+func TestYAMLMarshal_SortsMapKeys(t *testing.T) {
+	var value struct {
+		Counts map[string]int `yaml:"counts"`
+	}
+	populate(reflect.ValueOf(&value).Elem(), "value")
+
+	expected, err := yaml.Marshal(value)
+	require.NoError(t, err)
+	output := string(expected)
+	assert.Less(t, strings.Index(output, "alpha"), strings.Index(output, "beta"))
+	assert.Less(t, strings.Index(output, "beta"), strings.Index(output, "gamma"))
+	for range 20 {
+		data, err := yaml.Marshal(value)
+		require.NoError(t, err)
+		assert.Equal(t, output, string(data))
+	}
+}
+
+func TestVideo_TimestampSerialization(t *testing.T) {
+	testPath := filepath.Join(t.TempDir(), "video.yaml")
+	setNow(t, time.Date(2025, time.March, 1, 10, 0, 0, 123456789, time.FixedZone("CET", 3600)))
+
+	require.NoError(t, (&YAML{}).WriteVideo(Video{Name: "Video"}, testPath))
+	data, err := os.ReadFile(testPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "createdAt: 2025-03-01T09:00:00Z")
+	assert.Contains(t, string(data), "updatedAt: 2025-03-01T09:00:00Z")
+
+	video, err := (&YAML{}).GetVideo(testPath)
+	require.NoError(t, err)
+	jsonData, err := json.Marshal(video)
+	require.NoError(t, err)
+	assert.Contains(t, string(jsonData), `"createdAt":"2025-03-01T09:00:00Z"`)
+	assert.Contains(t, string(jsonData), `"updatedAt":"2025-03-01T09:00:00Z"`)
+
+	jsonData, err = json.Marshal(Video{Name: "Video"})
+	require.NoError(t, err)
+	assert.NotContains(t, string(jsonData), "createdAt", "unset timestamps are omitted")
+	yamlData, err := yaml.Marshal(Video{Name: "Video"})
+	require.NoError(t, err)
+	assert.NotContains(t, string(yamlData), "createdAt")
+}
+
+// TestWriteVideo_ReadOnlyDirectory verifies a clear error when the directory can't be written
+func TestWriteVideo_ReadOnlyDirectory(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("directory permissions are not enforced on Windows")
+	}
+	dir := t.TempDir()
+	require.NoError(t, os.Chmod(dir, 0555))
+	t.Cleanup(func() { os.Chmod(dir, 0755) })
+	if f, err := os.CreateTemp(dir, "probe"); err == nil {
+		f.Close()
+		t.Skip("write permission is not enforced for this user")
+	}
+
+	y := YAML{}
+	err := y.WriteVideo(Video{Name: "Video"}, filepath.Join(dir, "video.yaml"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotWritable)
+	assert.ErrorIs(t, err, os.ErrPermission)
+	assert.Contains(t, err.Error(), "metadata directory is not writable: "+dir)
+}
+
+// TestNotWritable verifies which errors are reported as an unwritable directory
+func TestNotWritable(t *testing.T) {
+	readOnly := notWritable("/videos", &os.PathError{Op: "open", Path: "/videos/video.yaml", Err: syscall.EROFS})
+	assert.ErrorIs(t, readOnly, ErrNotWritable)
+	assert.ErrorIs(t, readOnly, syscall.EROFS)
+
+	other := &os.PathError{Op: "open", Path: "/videos/video.yaml", Err: syscall.ENOSPC}
+	assert.Same(t, error(other), notWritable("/videos", other))
+}
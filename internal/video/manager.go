@@ -1,9 +1,9 @@
 package video
 
 import (
+	"devopstoolkit/youtube-automation/internal/constants"
 	"devopstoolkit/youtube-automation/internal/storage"
 	"devopstoolkit/youtube-automation/internal/workflow"
-	"strings"
 )
 
 // Manager handles video phase determination and lifecycle operations
@@ -60,7 +60,8 @@ func CalculateVideoPhase(video storage.Video) int {
 // SHARED PROGRESS CALCULATION FUNCTIONS
 // ===============================================
 // These functions provide a single source of truth for progress calculations
-// used by both CLI and API to ensure consistency
+// used by both CLI and API to ensure consistency. The per-phase task lists
+// live in storage.Video.PhaseProgress.
 
 // CalculateOverallProgress calculates the combined progress across all video phases
 // This function is used by both CLI and API to ensure consistent calculations
@@ -92,165 +93,30 @@ func (m *Manager) CalculateOverallProgress(video storage.Video) (int, int) {
 
 // CalculateDefinePhaseCompletion calculates the completed and total tasks for the Definition phase.
 func (m *Manager) CalculateDefinePhaseCompletion(video storage.Video) (completed int, total int) {
-	fieldsToCount := []interface{}{
-		video.Title,
-		video.Description,
-		video.Tags,
-		video.DescriptionTags,
-		video.Tweet,
-		video.Animations,
-		video.RequestThumbnail, // This is a bool
-		// Gist removed - it belongs to InitialDetails phase, not Definition phase
-	}
-	total = len(fieldsToCount)
-
-	for _, field := range fieldsToCount {
-		switch v := field.(type) {
-		case string:
-			if len(strings.TrimSpace(v)) > 0 && strings.TrimSpace(v) != "-" {
-				completed++
-			}
-		case bool:
-			if v { // For RequestThumbnail, true means complete
-				completed++
-			}
-		}
-	}
-	return completed, total
+	return video.PhaseProgress(constants.PhaseTitleDefinition)
 }
 
 // CalculateInitialDetailsProgress calculates Initial Details phase progress on-the-fly
 func (m *Manager) CalculateInitialDetailsProgress(video storage.Video) (int, int) {
-	var completedCount, totalCount int
-
-	// General fields
-	generalFields := []interface{}{
-		video.ProjectName,
-		video.ProjectURL,
-		video.Gist,
-		video.Date,
-	}
-	c, t := m.countCompletedTasks(generalFields)
-	completedCount += c
-	totalCount += t
-
-	// Sponsorship.Amount
-	totalCount++
-	if len(video.Sponsorship.Amount) > 0 {
-		completedCount++
-	}
-
-	// Special conditions (3 additional tasks)
-	totalCount += 3
-
-	// Condition 1: Sponsorship Emails
-	if len(video.Sponsorship.Amount) == 0 || video.Sponsorship.Amount == "N/A" || video.Sponsorship.Amount == "-" || len(video.Sponsorship.Emails) > 0 {
-		completedCount++
-	}
-
-	// Condition 2: Sponsorship Blocked
-	if len(video.Sponsorship.Blocked) == 0 {
-		completedCount++
-	}
-
-	// Condition 3: Delayed
-	if !video.Delayed {
-		completedCount++
-	}
-
-	return completedCount, totalCount
+	return video.PhaseProgress(constants.PhaseTitleInitialDetails)
 }
 
 // CalculateWorkProgressProgress calculates Work Progress phase progress on-the-fly
 func (m *Manager) CalculateWorkProgressProgress(video storage.Video) (int, int) {
-	fields := []interface{}{
-		video.Code,
-		video.Head,
-		video.Screen,
-		video.RelatedVideos,
-		video.Thumbnails,
-		video.Diagrams,
-		video.Screenshots,
-		video.Location,
-		video.Tagline,
-		video.TaglineIdeas,
-		video.OtherLogos,
-	}
-	return m.countCompletedTasks(fields)
+	return video.PhaseProgress(constants.PhaseTitleWorkProgress)
 }
 
 // CalculatePostProductionProgress calculates Post-Production phase progress on-the-fly
 func (m *Manager) CalculatePostProductionProgress(video storage.Video) (int, int) {
-	fields := []interface{}{
-		video.Thumbnail,
-		video.Members,
-		video.RequestEdit,
-		video.Movie,
-		video.Slides,
-	}
-	completed, total := m.countCompletedTasks(fields)
-
-	// Special handling for Timecodes
-	total++
-	if video.Timecodes != "" && !m.containsString(video.Timecodes, "FIXME:") {
-		completed++
-	}
-
-	return completed, total
+	return video.PhaseProgress(constants.PhaseTitlePostProduction)
 }
 
 // CalculatePublishingProgress calculates Publishing phase progress on-the-fly
 func (m *Manager) CalculatePublishingProgress(video storage.Video) (int, int) {
-	fields := []interface{}{
-		video.UploadVideo,
-		video.HugoPath,
-	}
-	return m.countCompletedTasks(fields)
+	return video.PhaseProgress(constants.PhaseTitlePublishingDetails)
 }
 
 // CalculatePostPublishProgress calculates Post-Publish phase progress on-the-fly
 func (m *Manager) CalculatePostPublishProgress(video storage.Video) (int, int) {
-	fields := []interface{}{
-		video.DOTPosted,
-		video.BlueSkyPosted,
-		video.LinkedInPosted,
-		video.SlackPosted,
-		video.YouTubeHighlight,
-		video.YouTubeComment,
-		video.YouTubeCommentReply,
-		video.GDE,
-		video.Repo,
-	}
-	completed, total := m.countCompletedTasks(fields)
-
-	// Special logic for NotifiedSponsors
-	total++
-	if video.NotifiedSponsors || len(video.Sponsorship.Amount) == 0 || video.Sponsorship.Amount == "N/A" || video.Sponsorship.Amount == "-" {
-		completed++
-	}
-
-	return completed, total
-}
-
-// countCompletedTasks counts completed tasks based on field values
-func (m *Manager) countCompletedTasks(fields []interface{}) (completed int, total int) {
-	for _, field := range fields {
-		switch v := field.(type) {
-		case string:
-			if len(v) > 0 && v != "-" {
-				completed++
-			}
-		case bool:
-			if v {
-				completed++
-			}
-		}
-		total++
-	}
-	return completed, total
-}
-
-// containsString checks if a string contains a substring
-func (m *Manager) containsString(s, substr string) bool {
-	return strings.Contains(s, substr)
+	return video.PhaseProgress(constants.PhaseTitlePostPublish)
 }
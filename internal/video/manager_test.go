@@ -1015,7 +1015,7 @@ func TestCalculatePostPublishProgress(t *testing.T) {
 	}
 }
 
-// Note: countCompletedTasks and containsString are private methods, tested indirectly through other functions
+// Note: the per-phase task lists live in storage.Video.PhaseProgress and are tested indirectly through these functions
 
 func TestGetVideoPhase_ErrorHandling(t *testing.T) {
 	// Test the error path in GetVideoPhase